@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Directives represents the generation directives of a column or table comment,
+// e.g. `order status @enum(pending=1,paid=2) @json:"status"`.
+type Directives struct {
+	Comment string       // comment without the directives
+	Type    string       // @type(T): Go type of the column
+	Import  string       // import path of the package of Type
+	Enum    []*EnumItem  // @enum(name=value,...): constants of the values of an integer column
+	Tags    []*StructTag // @key:"value": struct tags of the entity field
+	Exclude bool         // @exclude: the column or table is left out of the generated code
+	Policy  ColumnPolicy // @writable, @insertonly or @readonly: policy of the column, or of the columns of a table
+}
+
+// EnumItem represents a value of @enum.
+type EnumItem struct {
+	Name  string
+	Value int64
+}
+
+// StructTag represents a struct tag of an entity field.
+type StructTag struct {
+	Key   string
+	Value string
+}
+
+// directiveRegexp matches the directives of a comment, which start with @ at the beginning of the comment or after
+// a space, so e-mail addresses are left in the comment. A directive is followed by the arguments in parentheses,
+// or by a colon and the quoted value of a struct tag.
+var directiveRegexp = regexp.MustCompile(`(^|\s)@([A-Za-z][A-Za-z0-9_]*)(?::"((?:[^"\\]|\\.)*)"|\(([^)]*)\))?`)
+
+// parseDirectives parses the directives of a column or table comment. Words starting with @ which are not known
+// directives are left in the comment.
+func parseDirectives(comment string) (*Directives, error) {
+	directives := &Directives{}
+	var text strings.Builder
+	offset := 0
+	for _, m := range directiveRegexp.FindAllStringSubmatchIndex(comment, -1) {
+		name := comment[m[4]:m[5]]
+		isTag, hasArgs := m[6] != -1, m[8] != -1
+		var arg string
+		switch {
+		case isTag:
+			arg = comment[m[6]:m[7]]
+		case hasArgs:
+			arg = strings.TrimSpace(comment[m[8]:m[9]])
+		}
+		raw := strings.TrimSpace(comment[m[0]:m[1]])
+		switch {
+		case isTag:
+			if name == "db" {
+				return nil, fmt.Errorf("invalid directive %s, the db tag is the column name", raw)
+			}
+			if slices.ContainsFunc(directives.Tags, func(tag *StructTag) bool { return tag.Key == name }) {
+				return nil, fmt.Errorf("duplicate directive %s", raw)
+			}
+			directives.Tags = append(directives.Tags, &StructTag{Key: name, Value: arg})
+		case name == "type":
+			if arg == "" {
+				return nil, fmt.Errorf("invalid directive %s, use @type(T)", raw)
+			}
+			directives.Type, directives.Import = parseTypeDirective(arg)
+		case name == "enum":
+			items, err := parseEnumDirective(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid directive %s, %v", raw, err)
+			}
+			directives.Enum = items
+		case name == "exclude" && !hasArgs:
+			directives.Exclude = true
+		case (name == string(ColumnPolicyWritable) || name == string(ColumnPolicyInsertOnly) ||
+			name == string(ColumnPolicyReadOnly)) && !hasArgs:
+			directives.Policy = ColumnPolicy(name)
+		default:
+			continue
+		}
+		text.WriteString(comment[offset:m[0]])
+		text.WriteByte(' ')
+		offset = m[1]
+	}
+	text.WriteString(comment[offset:])
+	directives.Comment = strings.Join(strings.Fields(text.String()), " ")
+	return directives, nil
+}
+
+// parseEnumDirective parses the values of @enum, e.g. "pending=1,paid=2". A value without a number
+// is the number of the previous value plus one, or 0 for the first value.
+func parseEnumDirective(arg string) (items []*EnumItem, err error) {
+	next := int64(0)
+	for _, item := range strings.Split(arg, ",") {
+		name, number, ok := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("empty name of value %q", item)
+		}
+		value := next
+		if ok {
+			value, err = strconv.ParseInt(strings.TrimSpace(number), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number of value %q", item)
+			}
+		}
+		for _, other := range items {
+			if other.Name == name || other.Value == value {
+				return nil, fmt.Errorf("duplicate value %q", item)
+			}
+		}
+		items = append(items, &EnumItem{Name: name, Value: value})
+		next = value + 1
+	}
+	return
+}
+
+// parseTypeDirective returns the Go type of @type(T) and the import path of its package.
+// T may be qualified by the import path of its package, e.g. github.com/google/uuid.UUID -> uuid.UUID,
+// by a package of the standard library, e.g. net.IP, or by nothing for a type of the generated package.
+func parseTypeDirective(typ string) (goType, importPath string) {
+	name := strings.TrimLeft(typ, "*[]")
+	prefix := typ[:len(typ)-len(name)]
+	i := strings.LastIndex(name, ".")
+	if i == -1 {
+		return typ, ""
+	}
+	importPath = name[:i]
+	if !strings.Contains(importPath, "/") {
+		for _, q := range typeQualifiers {
+			if q.qualifier == importPath+"." {
+				importPath = q.importPath
+			}
+		}
+	}
+	return prefix + guessPackageName(importPath) + name[i:], importPath
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDirectives(t *testing.T) {
+	tests := []struct {
+		comment string
+		want    Directives
+		wantErr bool
+	}{
+		{comment: "user id", want: Directives{Comment: "user id"}},
+		{comment: "mail support@example.com @team", want: Directives{Comment: "mail support@example.com @team"}},
+		{
+			comment: `order status @enum(pending=1,paid=2) @json:"status"`,
+			want: Directives{
+				Comment: "order status",
+				Enum:    []*EnumItem{{Name: "pending", Value: 1}, {Name: "paid", Value: 2}},
+				Tags:    []*StructTag{{Key: "json", Value: "status"}},
+			},
+		},
+		{comment: "@enum(a, b=5, c)", want: Directives{Enum: []*EnumItem{{Name: "a"}, {Name: "b", Value: 5}, {Name: "c", Value: 6}}}},
+		{
+			comment: `@json:"note,omitempty" notes @validate:"max=10" @readonly`,
+			want: Directives{
+				Comment: "notes",
+				Tags:    []*StructTag{{Key: "json", Value: "note,omitempty"}, {Key: "validate", Value: "max=10"}},
+				Policy:  ColumnPolicyReadOnly,
+			},
+		},
+		{comment: "token\n@exclude", want: Directives{Comment: "token", Exclude: true}},
+		{comment: "@type(github.com/google/uuid.UUID)", want: Directives{Type: "uuid.UUID", Import: "github.com/google/uuid"}},
+		{comment: "@type(*net.IP)", want: Directives{Type: "*net.IP", Import: "net"}},
+		{comment: "@type(decimal.Decimal)", want: Directives{Type: "decimal.Decimal", Import: "github.com/shopspring/decimal"}},
+		{comment: "@type(Money)", want: Directives{Type: "Money"}},
+		{comment: "@exclude(x)", want: Directives{Comment: "@exclude(x)"}},
+		{comment: `@db:"id"`, wantErr: true},
+		{comment: `@json:"a" @json:"b"`, wantErr: true},
+		{comment: "@type()", wantErr: true},
+		{comment: "@enum(a=1,b=1)", wantErr: true},
+		{comment: "@enum(a=x)", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseDirectives(tt.comment)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseDirectives(%q) returns no error", tt.comment)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseDirectives(%q) error = %v", tt.comment, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("parseDirectives(%q) = %+v, want %+v", tt.comment, *got, tt.want)
+		}
+	}
+}
+
+func TestConvertColumnToGoTypeDirectives(t *testing.T) {
+	goldenOptions{}.apply()
+	tests := []struct {
+		column  ColumnEntity
+		want    string
+		wantErr bool
+	}{
+		{column: ColumnEntity{Field: "status", Type: "tinyint", Null: "NO", Comment: "@enum(a,b)"}, want: "OrdersStatus"},
+		{column: ColumnEntity{Field: "status", Type: "int unsigned", Null: "YES", Comment: "@enum(a,b)"}, want: "sql.Null[OrdersStatus]"},
+		{column: ColumnEntity{Field: "status", Type: "varchar(8)", Null: "NO", Comment: "@enum(a,b)"}, wantErr: true},
+		{column: ColumnEntity{Field: "status", Type: "tinyint(1)", Null: "NO", Comment: "@enum(a,b)"}, wantErr: true},
+		{column: ColumnEntity{Field: "id", Type: "char(36)", Null: "YES", Comment: "@type(github.com/google/uuid.UUID)"}, want: "sql.Null[uuid.UUID]"},
+		{column: ColumnEntity{Field: "id", Type: "char(36)", Null: "NO", Comment: "@type(Money) @enum(a)"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		directives, err := parseDirectives(tt.column.Comment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := convertColumnToGoType("orders", &tt.column, directives)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("convertColumnToGoType(%s %q) = %q, %v, want %q, error %v", tt.column.Type, tt.column.Comment, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
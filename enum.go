@@ -0,0 +1,142 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnumEntity represents a Go type generated for an ENUM or SET column, or an integer column with @enum.
+type EnumEntity struct {
+	Name       string // Go type name
+	Column     string
+	IsSet      bool
+	Underlying string // Go integer type of an @enum column, empty for ENUM and SET columns
+	Values     []*EnumValue
+}
+
+// EnumValue represents an allowed value of an ENUM or SET column, or of an @enum column.
+type EnumValue struct {
+	Name  string // Go constant name
+	Value string // integer literal of an @enum value
+	Label string // name of an @enum value
+}
+
+// getEnumTypeName returns the Go type name of an ENUM or SET column.
+func getEnumTypeName(table, column string) string {
+	return initialisms.ForceCamelIdentifier(table) + initialisms.ForceCamelIdentifier(column)
+}
+
+// getEnum returns the Go type to generate for an ENUM or SET column or an integer column with @enum,
+// or nil if the column is not an ENUM or SET or -enum is disabled, or its type is overridden by @type.
+func getEnum(table string, column *ColumnEntity, directives *Directives) *EnumEntity {
+	if directives.Type != "" {
+		return nil
+	}
+	if len(directives.Enum) != 0 {
+		return getIntEnum(table, column, directives.Enum)
+	}
+	if !enumTypes {
+		return nil
+	}
+	baseType := getBaseType(column.Type)
+	if baseType != "enum" && baseType != "set" {
+		return nil
+	}
+	values := extractEnumValues(column.Type)
+	if len(values) == 0 {
+		return nil
+	}
+	enum := &EnumEntity{
+		Name:   getEnumTypeName(table, column.Field),
+		Column: column.Field,
+		IsSet:  baseType == "set",
+	}
+	names := make(map[string]struct{}, len(values))
+	for i, value := range values {
+		name := enum.Name + getEnumValueIdent(value)
+		if _, ok := names[name]; ok {
+			name += strconv.Itoa(i)
+		}
+		names[name] = struct{}{}
+		enum.Values = append(enum.Values, &EnumValue{Name: name, Value: value})
+	}
+	return enum
+}
+
+// getIntEnum returns the Go type of an integer column with @enum, whose values are validated by convertColumnToGoType.
+func getIntEnum(table string, column *ColumnEntity, items []*EnumItem) *EnumEntity {
+	underlying, _ := convertDatabaseTypeToGoType(column.Type, false)
+	enum := &EnumEntity{
+		Name:       getEnumTypeName(table, column.Field),
+		Column:     column.Field,
+		Underlying: underlying,
+	}
+	names := make(map[string]struct{}, len(items))
+	for i, item := range items {
+		name := enum.Name + getEnumValueIdent(item.Name)
+		if _, ok := names[name]; ok {
+			name += strconv.Itoa(i)
+		}
+		names[name] = struct{}{}
+		enum.Values = append(enum.Values, &EnumValue{Name: name, Value: strconv.FormatInt(item.Value, 10), Label: item.Name})
+	}
+	return enum
+}
+
+// getEnumValueIdent converts an ENUM or SET value to the suffix of a Go identifier,
+// e.g. "shipped out" -> "ShippedOut", "" -> "Empty".
+func getEnumValueIdent(value string) string {
+	ident := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, value)
+	ident = strings.Trim(ident, "_")
+	if ident == "" {
+		return "Empty"
+	}
+	// Identifiers cannot start with a digit
+	if unicode.IsDigit(rune(ident[0])) {
+		ident = "v" + ident
+	}
+	return initialisms.ForceCamelIdentifier(ident)
+}
+
+// extractEnumValues extracts the value list of an ENUM or SET data type.
+// Examples: "enum('a','b')" -> ["a", "b"]
+//
+//	"set('it''s','a,b')" -> ["it's", "a,b"]
+func extractEnumValues(dataType string) (values []string) {
+	openParen := strings.Index(dataType, "(")
+	closeParen := strings.LastIndex(dataType, ")")
+	if openParen == -1 || closeParen < openParen {
+		return
+	}
+	content := dataType[openParen+1 : closeParen]
+	var value strings.Builder
+	quoted := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case !quoted:
+			if c == '\'' {
+				quoted = true
+				value.Reset()
+			}
+		case c == '\\' && i+1 < len(content):
+			i++
+			value.WriteByte(content[i])
+		case c == '\'' && i+1 < len(content) && content[i+1] == '\'':
+			i++
+			value.WriteByte('\'')
+		case c == '\'':
+			quoted = false
+			values = append(values, value.String())
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return
+}
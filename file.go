@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,13 +13,19 @@ var (
 	ErrFileAlreadyExists = errors.New("file already exist")
 )
 
-func getTableFile(fileName string) (f *os.File, err error) {
-	// trim `_` character from fileName
-	fileName = strings.Replace(fileName, "_", "", -1)
-	if fileName == "" {
-		return f, errors.New("error: fileName can not be empty")
-	}
-	fileName += ".go"
+// promptInput is read for the answers of the overwrite prompts.
+var promptInput io.Reader = os.Stdin
+
+// confirmOverwrite asks whether the existing file fileName in outputDir is overwritten.
+func confirmOverwrite(fileName string) bool {
+	fmt.Printf("file %s already exist, do you want to overwrite it? [y/n]: ", fileName)
+	var op string
+	fmt.Fscanln(promptInput, &op)
+	return strings.ToLower(op) == "y"
+}
+
+// getFile creates the file fileName in outputDir, asking whether an existing one is overwritten.
+func getFile(fileName string) (f *os.File, err error) {
 	filePath := filepath.Join(outputDir, fileName)
 	if _, err = os.Stat(filePath); err != nil {
 		if os.IsNotExist(err) {
@@ -26,52 +33,54 @@ func getTableFile(fileName string) (f *os.File, err error) {
 		}
 		return
 	}
-	fmt.Printf("file %s already exist, do you want to overwrite it? [y/n]: ", fileName)
-	var op string
-	fmt.Scanf("%s", &op)
-	if strings.ToLower(op) == "y" {
+	if confirmOverwrite(fileName) {
 		return os.Create(filePath)
 	}
 	return f, ErrFileAlreadyExists
 }
 
-func getTableCondsFile(fileName string) (f *os.File, err error) {
+// getTableFileWithSuffix creates the file of a table, named by the table without `_` and the suffix.
+func getTableFileWithSuffix(fileName, suffix string) (f *os.File, err error) {
 	// trim `_` character from fileName
 	fileName = strings.Replace(fileName, "_", "", -1)
 	if fileName == "" {
 		return f, errors.New("error: fileName can not be empty")
 	}
-	fileName += "conds.go"
-	filePath := filepath.Join(outputDir, fileName)
-	if _, err = os.Stat(filePath); err != nil {
-		if os.IsNotExist(err) {
-			return os.Create(filePath)
-		}
-		return
-	}
-	fmt.Printf("file %s already exist, do you want to overwrite it? [y/n]: ", fileName)
-	var op string
-	fmt.Scanf("%s", &op)
-	if strings.ToLower(op) == "y" {
-		return os.Create(filePath)
-	}
-	return f, ErrFileAlreadyExists
+	return getFile(fileName + suffix)
+}
+
+func getTableFile(fileName string) (f *os.File, err error) {
+	return getTableFileWithSuffix(fileName, ".go")
+}
+
+func getTableCondsFile(fileName string) (f *os.File, err error) {
+	return getTableFileWithSuffix(fileName, "conds.go")
+}
+
+func getTableRepositoryFile(fileName string) (f *os.File, err error) {
+	return getTableFileWithSuffix(fileName, "repository.go")
+}
+
+func getTableTestFile(fileName string) (f *os.File, err error) {
+	return getTableFileWithSuffix(fileName, "_dao_test.go")
 }
 
 func getInitDaoFile() (f *os.File, err error) {
-	fileName := "dao.go"
-	filePath := filepath.Join(outputDir, fileName)
-	if _, err = os.Stat(filePath); err != nil {
-		if os.IsNotExist(err) {
-			return os.Create(filePath)
-		}
-		return
-	}
-	fmt.Printf("file %s already exist, do you want to overwrite it? [y/n]: ", fileName)
-	var op string
-	fmt.Scanf("%s", &op)
-	if strings.ToLower(op) == "y" {
-		return os.Create(filePath)
-	}
-	return f, ErrFileAlreadyExists
+	return getFile("dao.go")
+}
+
+func getInitDaoTestFile() (f *os.File, err error) {
+	return getFile("dao_test.go")
+}
+
+func getTracingFile() (f *os.File, err error) {
+	return getFile("tracing.go")
+}
+
+func getMetricsFile() (f *os.File, err error) {
+	return getFile("metrics.go")
+}
+
+func getTypesFile() (f *os.File, err error) {
+	return getFile("types.go")
 }
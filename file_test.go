@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetFile(t *testing.T) {
+	outputDir = t.TempDir()
+	defer func() { outputDir, promptInput = "", os.Stdin }()
+	path := filepath.Join(outputDir, "users.go")
+	if err := os.WriteFile(path, []byte("package dao\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The answers are read a line per prompt
+	promptInput = strings.NewReader("n\ny\n")
+	if _, err := getTableFile("users"); err != ErrFileAlreadyExists {
+		t.Errorf("getTableFile() declined error = %v, want ErrFileAlreadyExists", err)
+	}
+	if content, _ := os.ReadFile(path); string(content) != "package dao\n" {
+		t.Errorf("getTableFile() declined truncates the file to %q", content)
+	}
+	f, err := getTableFile("users")
+	if err != nil {
+		t.Fatalf("getTableFile() accepted error = %v", err)
+	}
+	f.Close()
+	if content, _ := os.ReadFile(path); len(content) != 0 {
+		t.Errorf("getTableFile() accepted keeps the content %q", content)
+	}
+	// New files are created without a prompt
+	f, err = getTableCondsFile("user_orders")
+	if err != nil {
+		t.Fatalf("getTableCondsFile() error = %v", err)
+	}
+	f.Close()
+	if _, err = os.Stat(filepath.Join(outputDir, "userordersconds.go")); err != nil {
+		t.Errorf("getTableCondsFile() does not create userordersconds.go, %v", err)
+	}
+}
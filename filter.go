@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+)
+
+var (
+	// includedTables and excludedTables specify the table patterns of -tables, the excluded ones prefixed with "!".
+	includedTables, excludedTables []string
+	// excludedColumns and readOnlyColumns specify the column patterns of -exclude-columns and -readonly-columns.
+	excludedColumns, readOnlyColumns []string
+)
+
+// parseTablePatterns parses the table patterns of -tables, e.g. "users,tmp_*,!*_bak".
+func parseTablePatterns(list string) (included, excluded []string, err error) {
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		pattern, exclude := strings.CutPrefix(item, "!")
+		if pattern == "" {
+			continue
+		}
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, nil, fmt.Errorf("invalid table pattern %q, %v", item, err)
+		}
+		if exclude {
+			excluded = append(excluded, pattern)
+		} else {
+			included = append(included, pattern)
+		}
+	}
+	return
+}
+
+// parseColumnPatterns parses the column patterns of -exclude-columns and -readonly-columns,
+// e.g. "users.password_hash,*.deleted_at".
+func parseColumnPatterns(list string) (patterns []string, err error) {
+	for _, item := range strings.Split(list, ",") {
+		pattern := strings.TrimSpace(item)
+		if pattern == "" {
+			continue
+		}
+		if !strings.Contains(pattern, ".") {
+			return nil, fmt.Errorf("invalid column pattern %q, use table.column", item)
+		}
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid column pattern %q, %v", item, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return
+}
+
+// filterTables returns the tables included by -tables. With -shard, the physical tables name_NN of a sharded
+// table, which are not collapsed yet, match the patterns by their names and by the logical name, e.g. orders
+// or orders_* selects all of them. The patterns must select all or none of them, as the shards are routed by
+// their number.
+func filterTables(tables []*TableEntity) ([]*TableEntity, error) {
+	logicalNames := make(map[*TableEntity]string) // physical table -> logical name
+	var groups map[string][]*TableEntity
+	if shard {
+		groups = getShardGroups(tables)
+		for name, group := range groups {
+			for _, table := range group {
+				logicalNames[table] = name
+			}
+		}
+	}
+	filtered := make([]*TableEntity, 0, len(tables))
+	included := make(map[string]int) // logical name -> number of physical tables included
+	for _, table := range tables {
+		names := []*TableEntity{table}
+		name, ok := logicalNames[table]
+		if ok {
+			names = append(names, &TableEntity{Database: table.Database, Name: name})
+		}
+		if isTableIncluded(names...) {
+			filtered = append(filtered, table)
+			if ok {
+				included[name]++
+			}
+		}
+	}
+	for name, n := range included {
+		if n != len(groups[name]) {
+			return nil, fmt.Errorf("error: -tables selects %d of the %d physical tables of sharded table %s, select all or none of them",
+				n, len(groups[name]), name)
+		}
+	}
+	return filtered, nil
+}
+
+// isTableIncluded reports whether a table known by the names of the entities is generated, i.e. one of
+// its names matches an included pattern of -tables or there is none, and none matches an excluded pattern.
+func isTableIncluded(names ...*TableEntity) bool {
+	matchAny := func(patterns []string) bool {
+		return slices.ContainsFunc(names, func(table *TableEntity) bool {
+			return matchTable(patterns, table)
+		})
+	}
+	if len(includedTables) != 0 && !matchAny(includedTables) {
+		return false
+	}
+	return !matchAny(excludedTables)
+}
+
+// matchTable reports whether the name or the database.name of the table matches one of the patterns.
+func matchTable(patterns []string, table *TableEntity) bool {
+	for _, pattern := range patterns {
+		if match(pattern, table.Name) || match(pattern, table.Database+"."+table.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchColumn reports whether the table.column or database.table.column of the column matches one of the patterns.
+func matchColumn(patterns []string, table *TableEntity, column string) bool {
+	for _, pattern := range patterns {
+		i := strings.LastIndex(pattern, ".")
+		tablePattern, columnPattern := pattern[:i], pattern[i+1:]
+		if match(columnPattern, column) && matchTable([]string{tablePattern}, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the name matches the glob pattern, whose syntax is validated when parsing the flags.
+func match(pattern, name string) bool {
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
+// filterColumns removes the columns excluded by -exclude-columns or @exclude, and the unique indexes on them,
+// which can no longer be looked up by the DAO. The columns of the primary key cannot be excluded, as the DAO
+// orders and pages by it, and excluding a NOT NULL column without a default is warned about, as Insert fails.
+func filterColumns(table *TableEntity, columns []*ColumnEntity, indexes []*IndexEntityV5) ([]*ColumnEntity, []*IndexEntityV5, error) {
+	primary := make(map[string]struct{})
+	for _, index := range indexes {
+		if index.KeyName == "PRIMARY" {
+			primary[index.ColumnName] = struct{}{}
+		}
+	}
+	excluded := make(map[string]struct{})
+	filtered := make([]*ColumnEntity, 0, len(columns))
+	for _, column := range columns {
+		if !matchColumn(excludedColumns, table, column.Field) && !isExcludedByDirective(column) {
+			filtered = append(filtered, column)
+			continue
+		}
+		if _, ok := primary[column.Field]; ok || column.Key == "PRI" {
+			return nil, nil, fmt.Errorf("error: table %s, column %s of the primary key cannot be excluded", table.Name, column.Field)
+		}
+		if isRequiredColumn(column) {
+			slog.Warn(fmt.Sprintf("column %s of table %s is excluded but NOT NULL without a default, Insert will fail", column.Field, table.Name))
+		}
+		excluded[column.Field] = struct{}{}
+	}
+	if len(excluded) == 0 {
+		return columns, indexes, nil
+	}
+	excludedIndexes := make(map[string]struct{})
+	for _, index := range indexes {
+		if _, ok := excluded[index.ColumnName]; ok {
+			excludedIndexes[index.KeyName] = struct{}{}
+		}
+	}
+	filteredIndexes := make([]*IndexEntityV5, 0, len(indexes))
+	for _, index := range indexes {
+		if _, ok := excludedIndexes[index.KeyName]; !ok {
+			filteredIndexes = append(filteredIndexes, index)
+		}
+	}
+	return filtered, filteredIndexes, nil
+}
+
+// isRequiredColumn reports whether an insert requires a value of the column, i.e. it is NOT NULL without
+// a default and neither auto-increment nor generated.
+func isRequiredColumn(column *ColumnEntity) bool {
+	extra := strings.ToLower(column.Extra)
+	return column.Null == "NO" && !column.Default.Valid &&
+		!strings.Contains(extra, "auto_increment") && !strings.Contains(extra, "generated")
+}
+
+// isExcludedByDirective reports whether the comment of the column has @exclude,
+// the errors of the directives are reported by getRenderData.
+func isExcludedByDirective(column *ColumnEntity) bool {
+	directives, err := parseDirectives(column.Comment)
+	return err == nil && directives.Exclude
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestIsTableIncluded(t *testing.T) {
+	tests := []struct {
+		tables string
+		table  TableEntity
+		want   bool
+	}{
+		{tables: "", table: TableEntity{Database: "shop", Name: "users"}, want: true},
+		{tables: "users,orders", table: TableEntity{Database: "shop", Name: "users"}, want: true},
+		{tables: "users,orders", table: TableEntity{Database: "shop", Name: "user_orders"}, want: false},
+		{tables: "shop.users", table: TableEntity{Database: "shop", Name: "users"}, want: true},
+		{tables: "shop.users", table: TableEntity{Database: "crm", Name: "users"}, want: false},
+		{tables: "tmp_*", table: TableEntity{Database: "shop", Name: "tmp_import"}, want: true},
+		{tables: "!*_bak", table: TableEntity{Database: "shop", Name: "users_bak"}, want: false},
+		{tables: "!*_bak", table: TableEntity{Database: "shop", Name: "users"}, want: true},
+		{tables: "user*, !user_*", table: TableEntity{Database: "shop", Name: "user_orders"}, want: false},
+		{tables: "crm.*", table: TableEntity{Database: "shop", Name: "users"}, want: false},
+	}
+	for _, tt := range tests {
+		var err error
+		includedTables, excludedTables, err = parseTablePatterns(tt.tables)
+		if err != nil {
+			t.Fatalf("parseTablePatterns(%q) error = %v", tt.tables, err)
+		}
+		if got := isTableIncluded(&tt.table); got != tt.want {
+			t.Errorf("isTableIncluded(%s.%s) with -tables %q = %v, want %v", tt.table.Database, tt.table.Name, tt.tables, got, tt.want)
+		}
+	}
+	if _, _, err := parseTablePatterns("users,[a-"); err == nil {
+		t.Error("parseTablePatterns() of a malformed pattern returns no error")
+	}
+	includedTables, excludedTables = nil, nil
+}
+
+func TestFilterTables(t *testing.T) {
+	shard = true
+	defer func() { shard, includedTables, excludedTables = false, nil, nil }()
+	var tables []*TableEntity
+	for _, name := range []string{"orders_00", "orders_01", "orders_02", "users", "logs_00"} {
+		tables = append(tables, &TableEntity{Database: "shop", Name: name, Ident: name})
+	}
+	tests := []struct {
+		tables string
+		want   string // names of the filtered tables, or a substring of the error
+	}{
+		{tables: "", want: "orders_00,orders_01,orders_02,users,logs_00"},
+		{tables: "orders", want: "orders_00,orders_01,orders_02"},
+		{tables: "shop.orders", want: "orders_00,orders_01,orders_02"},
+		{tables: "orders_*", want: "orders_00,orders_01,orders_02"},
+		{tables: "!orders", want: "users,logs_00"},
+		{tables: "logs", want: ""}, // a single physical table is not sharded
+		{tables: "logs_00", want: "logs_00"},
+		{tables: "orders_0[01]", want: "selects 2 of the 3 physical tables of sharded table orders"},
+		{tables: "!orders_02", want: "selects 2 of the 3 physical tables of sharded table orders"},
+	}
+	for _, tt := range tests {
+		var err error
+		includedTables, excludedTables, err = parseTablePatterns(tt.tables)
+		if err != nil {
+			t.Fatalf("parseTablePatterns(%q) error = %v", tt.tables, err)
+		}
+		filtered, err := filterTables(tables)
+		if err != nil {
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("filterTables() with -tables %q error = %v, want %s", tt.tables, err, tt.want)
+			}
+			continue
+		}
+		var names []string
+		for _, table := range filtered {
+			names = append(names, table.Name)
+		}
+		if got := strings.Join(names, ","); got != tt.want {
+			t.Errorf("filterTables() with -tables %q = %s, want %s", tt.tables, got, tt.want)
+		}
+	}
+}
+
+func TestMatchColumn(t *testing.T) {
+	patterns, err := parseColumnPatterns("users.password_hash, *.deleted_*, crm.*.secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		table  TableEntity
+		column string
+		want   bool
+	}{
+		{table: TableEntity{Database: "shop", Name: "users"}, column: "password_hash", want: true},
+		{table: TableEntity{Database: "shop", Name: "orders"}, column: "password_hash", want: false},
+		{table: TableEntity{Database: "shop", Name: "orders"}, column: "deleted_at", want: true},
+		{table: TableEntity{Database: "crm", Name: "contacts"}, column: "secret", want: true},
+		{table: TableEntity{Database: "shop", Name: "contacts"}, column: "secret", want: false},
+	}
+	for _, tt := range tests {
+		if got := matchColumn(patterns, &tt.table, tt.column); got != tt.want {
+			t.Errorf("matchColumn(%s.%s.%s) = %v, want %v", tt.table.Database, tt.table.Name, tt.column, got, tt.want)
+		}
+	}
+	if _, err := parseColumnPatterns("password_hash"); err == nil {
+		t.Error("parseColumnPatterns() of a pattern without table returns no error")
+	}
+}
+
+func TestFilterColumns(t *testing.T) {
+	defer func(handler slog.Handler) { slog.SetDefault(slog.New(handler)) }(slog.Default().Handler())
+	var logs bytes.Buffer
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	table := &TableEntity{Database: "shop", Name: "users"}
+	columns := []*ColumnEntity{
+		{Field: "id", Type: "bigint", Null: "NO", Key: "PRI", Extra: "auto_increment"},
+		{Field: "email", Type: "varchar(64)", Null: "NO", Key: "UNI"},
+		{Field: "nickname", Type: "varchar(32)", Null: "YES"},
+		{Field: "status", Type: "tinyint", Null: "NO", Default: sql.NullString{String: "0", Valid: true}},
+	}
+	indexes := []*IndexEntityV5{{KeyName: "PRIMARY", ColumnName: "id"}, {KeyName: "email_uk", ColumnName: "email"}}
+	tests := []struct {
+		exclude string
+		want    string // names of the filtered columns, or a substring of the error
+		warn    string // column warned about, empty if there is none
+	}{
+		{exclude: "", want: "id,email,nickname,status"},
+		{exclude: "users.nickname,users.status", want: "id,email"},
+		{exclude: "users.email", want: "id,nickname,status", warn: "email"},
+		{exclude: "users.id", want: "column id of the primary key cannot be excluded"},
+		{exclude: "*.*", want: "column id of the primary key cannot be excluded"},
+	}
+	for _, tt := range tests {
+		var err error
+		if excludedColumns, err = parseColumnPatterns(tt.exclude); err != nil {
+			t.Fatalf("parseColumnPatterns(%q) error = %v", tt.exclude, err)
+		}
+		logs.Reset()
+		filtered, filteredIndexes, err := filterColumns(table, columns, indexes)
+		if err != nil {
+			if !strings.Contains(err.Error(), tt.want) || !strings.HasPrefix(err.Error(), "error: ") {
+				t.Errorf("filterColumns() with -exclude-columns %q error = %v, want %s", tt.exclude, err, tt.want)
+			}
+			continue
+		}
+		var names []string
+		for _, column := range filtered {
+			names = append(names, column.Field)
+		}
+		if got := strings.Join(names, ","); got != tt.want {
+			t.Errorf("filterColumns() with -exclude-columns %q = %s, want %s", tt.exclude, got, tt.want)
+		}
+		if tt.warn != "" && len(filteredIndexes) != 1 {
+			t.Errorf("filterColumns() with -exclude-columns %q keeps %d indexes, want the primary key", tt.exclude, len(filteredIndexes))
+		}
+		want := "column " + tt.warn + " of table users is excluded but NOT NULL without a default"
+		if tt.warn == "" && logs.Len() != 0 || tt.warn != "" && !strings.Contains(logs.String(), want) {
+			t.Errorf("filterColumns() with -exclude-columns %q logs %q, want a warning about %q", tt.exclude, logs.String(), tt.warn)
+		}
+	}
+	excludedColumns = nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TestData specifies the fixtures of the integration test of a table.
+type TestData struct {
+	Fixtures  []*FixtureEntity // columns inserted by the test
+	Conds     []*FixtureEntity // columns identifying a fixture row
+	Update    *FixtureEntity   // column updated by the test, nil if no column is writable
+	UpdateRow int              // fixture row whose value the updated column is set to
+}
+
+// FixtureEntity represents the fixture values of a column.
+type FixtureEntity struct {
+	Column string
+	Name   string // Go identifier of the column in the condition functions
+	Value  string // Go expression of the value of the n-th fixture row
+	Cond   string // Go expression of the condition value of the n-th fixture row
+}
+
+// intFixtureMax maps the integer types to the maximum fixture value of their signed and unsigned forms.
+var intFixtureMax = map[string][2]int64{
+	"tinyint":   {127, 255},
+	"smallint":  {32767, 65535},
+	"mediumint": {8388607, 16777215},
+	"int":       {2147483647, 4294967295},
+	"integer":   {2147483647, 4294967295},
+	"bigint":    {1 << 62, 1 << 62},
+}
+
+// getTestData returns the fixtures of the integration test of a table, or nil if no column identifies
+// the fixture rows, which requires a NOT NULL integer or string column that is not auto-incremented.
+func getTestData(table string, columns []*ColumnEntity, attrs []*AttrEntity, timeFields TimeFields,
+	shardData *ShardData) (*TestData, error) {
+	var data TestData
+	var shardKey *FixtureEntity
+	var shardKeyIdentifies bool
+	var pks, uniques, others []*FixtureEntity // candidates to identify the fixture rows
+	var policies []ColumnPolicy               // policies of the fixtures
+	for i, column := range columns {
+		attr := attrs[i]
+		isShardKey := shardData != nil && shardData.Key == column.Field
+		if attr.Policy == ColumnPolicyReadOnly {
+			continue
+		}
+		// The DAO sets the time fields and MySQL the auto-incremented columns, but inserts are routed by the shard key
+		if !isShardKey && (strings.Contains(strings.ToUpper(column.Extra), "AUTO_INCREMENT") ||
+			column.Field == timeFields.CreateTime || column.Field == timeFields.UpdateTime) {
+			continue
+		}
+		// The directives are validated by getRenderData
+		directives, _ := parseDirectives(column.Comment)
+		value, valueType, identifies, err := getFixtureValue(table, column, directives)
+		if err != nil {
+			return nil, fmt.Errorf("error: column %s.%s, %v", table, column.Field, err)
+		}
+		// The condition values of @type columns may not convert from the fixture values
+		if directives.Type != "" {
+			identifies = false
+		}
+		fixture := &FixtureEntity{
+			Column: column.Field,
+			Name:   attr.NameCamelIdent,
+			Value:  value,
+			Cond:   value,
+		}
+		if attr.NullKind != "" {
+			fixture.Value = "fixtureNull(n, " + value + ")"
+			identifies = false
+		}
+		if attr.Type != valueType {
+			fixture.Cond = attr.Type + "(" + value + ")"
+		}
+		data.Fixtures = append(data.Fixtures, fixture)
+		policies = append(policies, attr.Policy)
+		switch {
+		case isShardKey:
+			shardKey, shardKeyIdentifies = fixture, identifies
+		case !identifies:
+		case attr.IsPk:
+			pks = append(pks, fixture)
+		case column.Key == "UNI":
+			uniques = append(uniques, fixture)
+		default:
+			others = append(others, fixture)
+		}
+	}
+	// Operations on sharded tables require the shard key condition
+	if shardKey != nil {
+		data.Conds = append(data.Conds, shardKey)
+	}
+	if !shardKeyIdentifies {
+		candidates := slices.Concat(pks, uniques, others)
+		if len(candidates) == 0 {
+			return nil, nil
+		}
+		data.Conds = append(data.Conds, candidates[0])
+	}
+	// Update a writable column which does not identify the rows, or set an identifying one to its own value,
+	// the update is left out of the test if no column is writable. Sharded tables reject updates of the shard key.
+	for i, fixture := range data.Fixtures {
+		if policies[i] != ColumnPolicyWritable || fixture == shardKey {
+			continue
+		}
+		if !slices.Contains(data.Conds, fixture) {
+			data.Update, data.UpdateRow = fixture, 3
+			break
+		}
+		if data.Update == nil {
+			data.Update = fixture
+		}
+	}
+	return &data, nil
+}
+
+// getFixtureValue returns the Go expression of the value of a column in the n-th fixture row, the Go type
+// of the expression, and whether the values of different rows are unique enough to identify the rows.
+func getFixtureValue(table string, column *ColumnEntity, directives *Directives) (value, valueType string, identifies bool, err error) {
+	if len(directives.Enum) != 0 {
+		values := make([]string, 0, len(directives.Enum))
+		for _, item := range directives.Enum {
+			values = append(values, strconv.FormatInt(item.Value, 10))
+		}
+		return fmt.Sprintf("[]int64{%s}[n%%%d]", strings.Join(values, ", "), len(values)), "int64", false, nil
+	}
+	dataType := strings.ToLower(column.Type)
+	unsigned := strings.HasSuffix(dataType, " unsigned") || strings.HasSuffix(dataType, " zerofill")
+	baseType := getBaseType(dataType)
+	_, precision, scale := extractPrecisionAndScale(dataType)
+	switch baseType {
+	case "bool", "boolean":
+		return "n%2 == 0", "bool", false, nil
+	case "bit":
+		if precision == 1 {
+			return "n%2 == 0", "bool", false, nil
+		}
+		return "int64(n % 2)", "int64", false, nil
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		if baseType == "tinyint" && precision == 1 {
+			return "n%2 == 0", "bool", false, nil
+		}
+		maxValue := intFixtureMax[baseType][0]
+		if unsigned {
+			maxValue = intFixtureMax[baseType][1]
+		}
+		// Small integers repeat too often to identify the rows
+		return fmt.Sprintf("fixtureInt(n, %d)", maxValue), "int64", maxValue >= intFixtureMax["mediumint"][0], nil
+	case "year":
+		return "int64(2000 + n)", "int64", false, nil
+	case "float", "double", "real":
+		return "float64(n) + 0.5", "float64", false, nil
+	case "decimal", "numeric":
+		if precision < 0 {
+			precision = 10
+		}
+		if scale < 0 {
+			scale = 0
+		}
+		return fmt.Sprintf("fixtureDecimal(n, %d, %d)", precision, scale), "string", false, nil
+	case "char", "varchar", "tinytext", "text", "mediumtext", "longtext":
+		size := precision
+		if size < 0 {
+			size = 64
+		}
+		return fmt.Sprintf("fixtureString(n, %d)", size), "string", size >= 8, nil
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		size := precision
+		if size < 0 {
+			size = 64
+		}
+		return fmt.Sprintf("[]byte(fixtureString(n, %d))", size), "[]byte", false, nil
+	case "json":
+		// Typed JSON columns may not unmarshal an object, but every type unmarshals null
+		if _, ok := jsonTypes[table+"."+column.Field]; ok {
+			return strconv.Quote("null"), "string", false, nil
+		}
+		return "fixtureJSON(n)", "string", false, nil
+	case "enum", "set":
+		values := extractEnumValues(column.Type)
+		if len(values) == 0 {
+			return "", "", false, fmt.Errorf("no values of data type %q", column.Type)
+		}
+		quoted := make([]string, 0, len(values))
+		for _, v := range values {
+			quoted = append(quoted, strconv.Quote(v))
+		}
+		return fmt.Sprintf("[]string{%s}[n%%%d]", strings.Join(quoted, ", "), len(values)), "string", false, nil
+	case "timestamp", "datetime", "date":
+		return "fixtureTime", "time.Time", false, nil
+	case "time":
+		return strconv.Quote("01:02:03"), "string", false, nil
+	case "geometry", "point", "linestring", "polygon", "multipoint", "multilinestring",
+		"multipolygon", "geometrycollection", "geomcollection":
+		return fmt.Sprintf("Geometry{WKB: fixtureWKB(%q)}", baseType), "Geometry", false, nil
+	}
+	return "", "", false, fmt.Errorf("unsupported data type %q", column.Type)
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	mysqlDrv "github.com/go-sql-driver/mysql"
@@ -23,6 +24,10 @@ Usage 1:
 
 Usage 2(Specified tables):
 	go-dao-code-gen -h 127.0.0.1 -u user -p passwd -D dbname -o ./dao -tables "tbl1,tbl2"
+
+	or
+
+	go-dao-code-gen -h 127.0.0.1 -u user -p passwd -D dbname -o ./dao -tables "tbl*,!*_bak"
 `
 )
 
@@ -33,8 +38,38 @@ var (
 	// host, user, passwd, port, dbname, params
 	h, u, p, P, D, params string // When dsn parameters are present, these parameters are ignored
 	tables                string
+	databases             string
+	databaseList          []string
 
 	outputDir string // Output directory
+
+	excludeColumnList  string // Columns left out of the generated code, "table.column" pattern list
+	readOnlyColumnList string // Columns never written by Insert and Update, "table.column" pattern list
+	columnPolicyList   string // Policies of columns, "table.column=policy" list
+	structTagList      string // Struct tags of the entity fields besides db, "key[:case]" list
+
+	decimal     string // Mapping mode of decimal columns
+	decimalMode DecimalMode
+	null        string // Mapping mode of nullable columns
+	nullMode    NullMode
+	exactInt    bool // Map integer columns to Go types of the same width and signedness
+
+	jsonMapping  string // Mapping mode of JSON columns
+	jsonMode     JSONMode
+	jsonTypeList string            // Element types of JSON columns, "table.column=Type" list
+	jsonTypes    map[string]string // table.column -> element type
+
+	enumTypes bool // Generate Go types for ENUM and SET columns
+
+	tracing bool // Generate OpenTelemetry tracing of DAO operations
+	metrics bool // Generate Prometheus metrics of DAO operations and connection pools
+
+	shard        bool              // Collapse the physical tables name_NN into one sharded table
+	shardKeyList string            // Shard key columns of sharded tables, "table=column" list
+	shardKeys    map[string]string // table -> shard key column
+
+	genTests bool // Generate integration tests of the table DAOs
+	verify   bool // Type check the generated package before writing the files
 )
 
 func parseFlags() {
@@ -57,15 +92,55 @@ func parseFlags() {
 
 	flag.StringVar(&params, "params", "", "Connection parameters.")
 
-	flag.StringVar(&tables, "tables", "", "Generation range of tables, use \",\" separate multiple tables.")
+	flag.StringVar(&tables, "tables", "", "Generation range of tables, use \",\" separate multiple tables or glob patterns, \"!\" to exclude tables, and database.table for a table of -databases.")
+
+	flag.StringVar(&databases, "databases", "", "Generate tables of several databases into one package, use \",\" separate multiple databases.")
 
 	// Output config
 	flag.StringVar(&outputDir, "o", "", "Output directory.")
 
+	// Column config
+	flag.StringVar(&excludeColumnList, "exclude-columns", "", "Columns left out of the generated code, use \"table.column\" or glob patterns and \",\" separate multiple columns.")
+
+	flag.StringVar(&readOnlyColumnList, "readonly-columns", "", "Columns never written by Insert and Update, use \"table.column\" or glob patterns and \",\" separate multiple columns.")
+
+	flag.StringVar(&columnPolicyList, "column-policies", "", "Policies of columns: writable, insertonly or readonly, use \"table.column=policy\" or glob patterns and \",\" separate multiple columns, overriding the policies derived from the columns.")
+
+	flag.StringVar(&structTagList, "tags", "", "Struct tags of the entity fields besides db, e.g. json, yaml, gorm, validate or custom keys, use \"key:case\" with the case snake (default) or camel and \",\" separate multiple tags.")
+
+	// Type mapping config
+	flag.StringVar(&decimal, "decimal", string(DecimalModeFloat), "Go type of decimal columns: float, decimal (shopspring/decimal) or string.")
+
+	flag.BoolVar(&exactInt, "exact-int", false, "Map integer columns to Go types of the same width and signedness, e.g. int unsigned -> uint32.")
+
+	flag.StringVar(&jsonMapping, "json", string(JSONModeString), "Go type of JSON columns: string, raw (json.RawMessage) or typed (JSON[T]).")
+
+	flag.StringVar(&jsonTypeList, "json-types", "", "Element types of JSON[T] columns, use \"table.column=Type\" and \",\" separate multiple columns.")
+
+	flag.BoolVar(&enumTypes, "enum", false, "Generate Go types with constants for ENUM and SET columns.")
+
+	flag.StringVar(&null, "null", string(NullModeSQL), "Go type of nullable columns: sql (sql.NullString...), generic (sql.Null[T]) or pointer (*T).")
+
+	// Observability config
+	flag.BoolVar(&tracing, "tracing", false, "Generate tracing.go, which starts an OpenTelemetry span for every DAO operation.")
+
+	flag.BoolVar(&metrics, "metrics", false, "Generate metrics.go, which exposes Prometheus metrics of DAO operations and connection pools through dao.WithMetrics.")
+
+	// Sharding config
+	flag.BoolVar(&shard, "shard", false, "Generate one sharded table for the physical tables name_NN, which routes operations by the shard key.")
+
+	flag.StringVar(&shardKeyList, "shard-keys", "", "Shard key columns of sharded tables, use \"table=column\" and \",\" separate multiple tables, the primary key by default.")
+
+	// Test config
+	flag.BoolVar(&genTests, "gen-tests", false, "Generate integration tests of the table DAOs, which run against the database of the DAO_TEST_DSN environment variable.")
+
+	// Verification config
+	flag.BoolVar(&verify, "verify", false, "Type check the generated package with the Go toolchain before writing the files, which are not written if it has type errors.")
+
 	flag.Parse()
 	// Validate flag vars
 	if help {
-		fmt.Println(cmdQuote)
+		fmt.Print(cmdQuote)
 		flag.PrintDefaults()
 		os.Exit(0)
 	}
@@ -75,7 +150,7 @@ func parseFlags() {
 	}
 	dsn = strings.TrimSpace(dsn)
 	if dsn == "" {
-		if D == "" {
+		if D == "" && strings.TrimSpace(databases) == "" {
 			if u != "user" {
 				D = u
 			} else {
@@ -118,15 +193,84 @@ func parseFlags() {
 		fmt.Printf("Error: get absolute output path failed.\n")
 		os.Exit(1)
 	}
-	tables = strings.TrimSpace(tables)
-	if tables != "" {
-		tablesList := strings.Split(tables, ",")
-		for _, table := range tablesList {
-			table = strings.TrimSpace(table)
-			if table == "" {
+	decimalMode = DecimalMode(strings.ToLower(strings.TrimSpace(decimal)))
+	switch decimalMode {
+	case DecimalModeFloat, DecimalModeDecimal, DecimalModeString:
+	default:
+		fmt.Printf("Error: invalid -decimal value %q, use float, decimal or string.\n", decimal)
+		os.Exit(1)
+	}
+	nullMode = NullMode(strings.ToLower(strings.TrimSpace(null)))
+	switch nullMode {
+	case NullModeSQL, NullModeGeneric, NullModePointer:
+	default:
+		fmt.Printf("Error: invalid -null value %q, use sql, generic or pointer.\n", null)
+		os.Exit(1)
+	}
+	jsonMode = JSONMode(strings.ToLower(strings.TrimSpace(jsonMapping)))
+	switch jsonMode {
+	case JSONModeString, JSONModeRaw, JSONModeTyped:
+	default:
+		fmt.Printf("Error: invalid -json value %q, use string, raw or typed.\n", jsonMapping)
+		os.Exit(1)
+	}
+	jsonTypeList = strings.TrimSpace(jsonTypeList)
+	if jsonTypeList != "" {
+		for _, item := range strings.Split(jsonTypeList, ",") {
+			column, elemType, ok := strings.Cut(item, "=")
+			column, elemType = strings.TrimSpace(column), strings.TrimSpace(elemType)
+			if !ok || !strings.Contains(column, ".") || elemType == "" {
+				fmt.Printf("Error: invalid -json-types item %q, use table.column=Type.\n", item)
+				os.Exit(1)
+			}
+			jsonTypes[column] = elemType
+		}
+	}
+	shardKeyList = strings.TrimSpace(shardKeyList)
+	if shardKeyList != "" {
+		for _, item := range strings.Split(shardKeyList, ",") {
+			table, column, ok := strings.Cut(item, "=")
+			table, column = strings.TrimSpace(table), strings.TrimSpace(column)
+			if !ok || table == "" || column == "" {
+				fmt.Printf("Error: invalid -shard-keys item %q, use table=column.\n", item)
+				os.Exit(1)
+			}
+			shardKeys[table] = column
+		}
+	}
+	databases = strings.TrimSpace(databases)
+	if databases != "" {
+		for _, database := range strings.Split(databases, ",") {
+			database = strings.TrimSpace(database)
+			if database == "" || slices.Contains(databaseList, database) {
 				continue
 			}
-			tablesMap[table] = struct{}{}
+			databaseList = append(databaseList, database)
 		}
 	}
+	includedTables, excludedTables, err = parseTablePatterns(tables)
+	if err != nil {
+		fmt.Printf("Error: invalid -tables value, %v.\n", err)
+		os.Exit(1)
+	}
+	excludedColumns, err = parseColumnPatterns(excludeColumnList)
+	if err != nil {
+		fmt.Printf("Error: invalid -exclude-columns value, %v.\n", err)
+		os.Exit(1)
+	}
+	readOnlyColumns, err = parseColumnPatterns(readOnlyColumnList)
+	if err != nil {
+		fmt.Printf("Error: invalid -readonly-columns value, %v.\n", err)
+		os.Exit(1)
+	}
+	columnPolicies, err = parseColumnPolicies(columnPolicyList)
+	if err != nil {
+		fmt.Printf("Error: invalid -column-policies value, %v.\n", err)
+		os.Exit(1)
+	}
+	structTags, err = parseStructTags(structTagList)
+	if err != nil {
+		fmt.Printf("Error: invalid -tags value, %v.\n", err)
+		os.Exit(1)
+	}
 }
@@ -12,4 +12,5 @@ require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/go-bindata/go-bindata v3.1.2+incompatible // indirect
 	github.com/huandu/xstrings v1.4.0 // indirect
+	github.com/shopspring/decimal v1.4.0 // indirect
 )
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+)
+
+// declaration represents a package-level identifier declared by the generated files.
+type declaration struct {
+	name  string
+	owner string // what declares the identifier, e.g. "table users"
+	hint  string // how to resolve a collision of the identifier, empty if the schema cannot be changed
+}
+
+// checkDeclarations returns an error if two generated declarations have the same identifier, which would
+// not compile, e.g. the enum type of a column named dao and the DAO type of its table, or the constant of
+// an ENUM value named values and the Values function of the enum type.
+func checkDeclarations(pkg string, rDataList []*RenderData) error {
+	shared, err := getSharedDeclarations(pkg, rDataList)
+	if err != nil {
+		return err
+	}
+	owners := make(map[string]declaration)
+	declare := func(decls []declaration) error {
+		for _, decl := range decls {
+			other, ok := owners[decl.name]
+			if !ok {
+				owners[decl.name] = decl
+				continue
+			}
+			err := fmt.Errorf("error: identifier %s of %s collides with the one of %s", decl.name, decl.owner, other.owner)
+			if hint := cmp.Or(decl.hint, other.hint); hint != "" {
+				err = fmt.Errorf("%w, %s", err, hint)
+			}
+			return err
+		}
+		return nil
+	}
+	if err = declare(shared); err != nil {
+		return err
+	}
+	for _, rData := range rDataList {
+		if err = declare(getTableDeclarations(rData)); err != nil {
+			return err
+		}
+	}
+	// The enum types are declared last, as they are named after the columns and values
+	for _, rData := range rDataList {
+		for _, enum := range rData.Enums {
+			if err = declare(getEnumDeclarations(rData, enum)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getSharedDeclarations returns the package-level identifiers declared by the files shared by the tables,
+// i.e. dao.go and the types.go, tracing.go and metrics.go generated for the tables.
+func getSharedDeclarations(pkg string, rDataList []*RenderData) ([]declaration, error) {
+	var types SharedTypes
+	for _, rData := range rDataList {
+		types = types.Merge(rData.Types)
+	}
+	content, err := renderInitDao(&RenderData{Pkg: pkg, Sharding: shard})
+	if err != nil {
+		return nil, fmt.Errorf("error: render dao tpl failed, %v", err)
+	}
+	files := map[string][]byte{"dao.go": content}
+	if types.Any() {
+		if files["types.go"], err = renderTypes(&RenderData{Pkg: pkg, Types: types}); err != nil {
+			return nil, fmt.Errorf("error: render types tpl failed, %v", err)
+		}
+	}
+	if tracing {
+		if files["tracing.go"], err = renderTracing(&RenderData{Pkg: pkg}); err != nil {
+			return nil, fmt.Errorf("error: render tracing tpl failed, %v", err)
+		}
+	}
+	if metrics {
+		if files["metrics.go"], err = renderMetrics(&RenderData{Pkg: pkg}); err != nil {
+			return nil, fmt.Errorf("error: render metrics tpl failed, %v", err)
+		}
+	}
+	var decls []declaration
+	for _, name := range []string{"dao.go", "types.go", "tracing.go", "metrics.go"} {
+		if content, ok := files[name]; ok {
+			names, err := getFileDeclarations(name, content)
+			if err != nil {
+				return nil, err
+			}
+			for _, ident := range names {
+				decls = append(decls, declaration{name: ident, owner: name})
+			}
+		}
+	}
+	return decls, nil
+}
+
+// getFileDeclarations returns the package-level identifiers declared by a Go file, except for init and _.
+func getFileDeclarations(name string, content []byte) (names []string, err error) {
+	file, err := parser.ParseFile(token.NewFileSet(), name, content, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("error: parse %s failed, %v", name, err)
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil && decl.Name.Name != "init" {
+				names = append(names, decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, ident := range spec.Names {
+						if ident.Name != "_" {
+							names = append(names, ident.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+// getTableDeclarations returns the package-level identifiers declared by the table, conds and repository
+// files of a table, besides its enum types.
+func getTableDeclarations(rData *RenderData) []declaration {
+	upper, lower := rData.TableUpperCamelIdent, rData.TableLowerCamelIdent
+	names := []string{
+		upper + "TableName", upper + "Database", "Max" + upper + "Limit",
+		lower + "Alias", lower + "Fields", lower + "UniqueIndexes", lower + "ColumnPolicies", lower + "Constraints",
+		upper + "Dao", upper + "Alias", upper + "Entity", "New" + upper + "Dao", upper + "ColumnPolicy",
+		upper + "Conds", upper + "Cond", "New" + upper + "Conds", "Build" + upper + "Conds",
+		upper + "Repository", "Fake" + upper + "Repository", "NewFake" + upper + "Repository",
+	}
+	if rData.Shard != nil {
+		names = append(names, upper+"ShardKey", lower+"Shards", lower+"ShardResolver",
+			"Set"+upper+"ShardResolver", upper+"Shards")
+	}
+	for _, attr := range rData.Attrs {
+		cond := "Set" + upper + attr.NameCamelIdent
+		names = append(names, cond)
+		if attr.IsJSON {
+			names = append(names, cond+"Contains", cond+"Extract")
+		}
+	}
+	decls := make([]declaration, 0, len(names))
+	for _, name := range names {
+		decls = append(decls, declaration{name: name, owner: "table " + rData.Table})
+	}
+	return decls
+}
+
+// getEnumDeclarations returns the package-level identifiers declared by the enum type of a column,
+// i.e. the type, its functions and variables, and the constants of its values.
+func getEnumDeclarations(rData *RenderData, enum *EnumEntity) []declaration {
+	column := rData.Table + "." + enum.Column
+	hint := "override the type of the column by @type or disable -enum"
+	if enum.Underlying != "" {
+		hint = "override the type of the column by @type"
+	}
+	owner := "the enum type of column " + column
+	decls := []declaration{{name: enum.Name, owner: owner, hint: hint}}
+	if enum.IsSet {
+		decls = append(decls, declaration{name: "Parse" + enum.Name, owner: owner, hint: hint},
+			declaration{name: lowerFirst(enum.Name) + "Members", owner: owner, hint: hint})
+	} else {
+		decls = append(decls, declaration{name: enum.Name + "Values", owner: owner, hint: hint})
+	}
+	for _, value := range enum.Values {
+		decl := declaration{name: value.Name, owner: fmt.Sprintf("the value %q of column %s", value.Value, column), hint: hint}
+		if enum.Underlying != "" {
+			decl.owner = fmt.Sprintf("the value %s of column %s", value.Label, column)
+			decl.hint = "rename the value in @enum"
+		}
+		decls = append(decls, decl)
+	}
+	return decls
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckDeclarations(t *testing.T) {
+	goldenOptions{Enum: true}.apply()
+	defer goldenOptions{}.apply()
+	getRData := func(table string, columns ...*ColumnEntity) *RenderData {
+		t.Helper()
+		columns = append([]*ColumnEntity{{Field: "id", Type: "bigint", Null: "NO", Key: "PRI"}}, columns...)
+		indexes := []*IndexEntityV5{{KeyName: "PRIMARY", ColumnName: "id"}}
+		rData, _, err := getRenderData(context.Background(), "dao", &TableEntity{Name: table, Ident: table}, columns, indexes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rData
+	}
+	tests := []struct {
+		name   string
+		tables []*RenderData
+		want   string // substring of the error, empty if there is none
+	}{
+		{
+			"no collision",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "status", Type: "enum('values_list','dao')", Null: "NO"},
+				&ColumnEntity{Field: "tags", Type: "set('a','b')", Null: "YES"})},
+			"",
+		},
+		{
+			"enum type of column dao",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "dao", Type: "enum('a','b')", Null: "NO"})},
+			"identifier OrdersDao of the enum type of column orders.dao collides with the one of table orders, " +
+				"override the type of the column by @type or disable -enum",
+		},
+		{
+			"enum value values",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "kind", Type: "enum('keys','values')", Null: "NO"})},
+			`identifier OrdersKindValues of the value "values" of column orders.kind collides with the one of the enum type of column orders.kind`,
+		},
+		{
+			"@enum value",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "state", Type: "tinyint", Null: "NO", Comment: "@enum(values=1,done)"})},
+			"identifier OrdersStateValues of the value values of column orders.state collides with the one of the enum type " +
+				"of column orders.state, rename the value in @enum",
+		},
+		{
+			"@enum type of column repository",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "repository", Type: "tinyint", Null: "NO", Comment: "@enum(a,b)"})},
+			"identifier OrdersRepository of the enum type of column orders.repository collides with the one of table orders, " +
+				"override the type of the column by @type",
+		},
+		{
+			"enum type overridden by @type",
+			[]*RenderData{getRData("orders", &ColumnEntity{Field: "dao", Type: "enum('a','b')", Null: "NO", Comment: "@type(string)"})},
+			"",
+		},
+		{
+			"enum value of another table",
+			[]*RenderData{getRData("users", &ColumnEntity{Field: "status", Type: "enum('dao','b')", Null: "NO"}),
+				getRData("users_status")},
+			`identifier UsersStatusDao of the value "dao" of column users.status collides with the one of table users_status`,
+		},
+		{
+			"SET type of dao.go",
+			[]*RenderData{getRData("hook", &ColumnEntity{Field: "funcs", Type: "set('a','b')", Null: "YES"})},
+			"identifier HookFuncs of the enum type of column hook.funcs collides with the one of dao.go",
+		},
+	}
+	for _, tt := range tests {
+		err := checkDeclarations("dao", tt.tables)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: checkDeclarations() = %v, want nil", tt.name, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: checkDeclarations() = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestGetTableDeclarations(t *testing.T) {
+	goldenOptions{Enum: true}.apply()
+	defer goldenOptions{}.apply()
+	table := &TableEntity{Name: "orders", Ident: "orders"}
+	columns := []*ColumnEntity{
+		{Field: "id", Type: "bigint", Null: "NO", Key: "PRI"},
+		{Field: "status", Type: "enum('pending','paid')", Null: "NO"},
+		{Field: "tags", Type: "set('a','b')", Null: "YES"},
+		{Field: "level", Type: "tinyint", Null: "NO", Comment: "@enum(low=1,high)"},
+		{Field: "attrs", Type: "json", Null: "YES"},
+	}
+	indexes := []*IndexEntityV5{{KeyName: "PRIMARY", ColumnName: "id"}}
+	rData, imports, err := getRenderData(context.Background(), "dao", table, columns, indexes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rData.Imports = append(imports, "database/sql", "database/sql/driver", "strings")
+	var want []string
+	for _, decl := range getTableDeclarations(rData) {
+		want = append(want, decl.name)
+	}
+	for _, enum := range rData.Enums {
+		for _, decl := range getEnumDeclarations(rData, enum) {
+			want = append(want, decl.name)
+		}
+	}
+	// The declarations must list every identifier declared by the files of the table
+	var got []string
+	for name, render := range map[string]func(string, *RenderData) ([]byte, error){
+		"orders.go":           renderTable,
+		"ordersconds.go":      renderTableConds,
+		"ordersrepository.go": renderTableRepository,
+	} {
+		content, err := render("orders", rData)
+		if err != nil {
+			t.Fatalf("render %s failed, %v", name, err)
+		}
+		names, err := getFileDeclarations(name, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, names...)
+	}
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("declarations = %v, want %v", want, got)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"math"
+	"strings"
+)
+
+// intRange represents the range of the values of an integer type.
+type intRange struct {
+	min int64
+	max uint64
+}
+
+// intRanges maps the integer data types to their signed and unsigned ranges.
+var intRanges = map[string][2]intRange{
+	"tinyint":   {{math.MinInt8, math.MaxInt8}, {0, math.MaxUint8}},
+	"smallint":  {{math.MinInt16, math.MaxInt16}, {0, math.MaxUint16}},
+	"mediumint": {{-1 << 23, 1<<23 - 1}, {0, 1<<24 - 1}},
+	"int":       {{math.MinInt32, math.MaxInt32}, {0, math.MaxUint32}},
+	"integer":   {{math.MinInt32, math.MaxInt32}, {0, math.MaxUint32}},
+	"bigint":    {{math.MinInt64, math.MaxInt64}, {0, math.MaxUint64}},
+}
+
+// goIntRanges maps the Go integer types to their ranges, assuming int is 64 bits wide.
+var goIntRanges = map[string]intRange{
+	"int8":   {math.MinInt8, math.MaxInt8},
+	"int16":  {math.MinInt16, math.MaxInt16},
+	"int32":  {math.MinInt32, math.MaxInt32},
+	"int64":  {math.MinInt64, math.MaxInt64},
+	"int":    {math.MinInt64, math.MaxInt64},
+	"uint8":  {0, math.MaxUint8},
+	"uint16": {0, math.MaxUint16},
+	"uint32": {0, math.MaxUint32},
+	"uint64": {0, math.MaxUint64},
+	"uint":   {0, math.MaxUint64},
+}
+
+// getIntRange returns the range of an integer data type, e.g. "tinyint unsigned" -> [0, 255].
+func getIntRange(dataType string) (intRange, bool) {
+	dataType = strings.ToLower(dataType)
+	ranges, ok := intRanges[getBaseType(dataType)]
+	if !ok {
+		return intRange{}, false
+	}
+	if strings.HasSuffix(dataType, " unsigned") || strings.HasSuffix(dataType, " zerofill") {
+		return ranges[1], true
+	}
+	return ranges[0], true
+}
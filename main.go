@@ -1,6 +1,7 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"fmt"
@@ -23,13 +24,13 @@ const (
 
 var (
 	err         error
-	tablesMap   map[string]struct{}
 	mysqlDB     *sql.DB
 	initialisms *snaker.Initialisms
 )
 
 func init() {
-	tablesMap = make(map[string]struct{})
+	jsonTypes = make(map[string]string)
+	shardKeys = make(map[string]string)
 	initialisms, err = snaker.NewDefaultInitialisms()
 	if err != nil {
 		slog.Error("error: create initialisms failed", "error", err)
@@ -47,8 +48,14 @@ type AttrEntity struct {
 	Type           string
 	Tag            string
 	Comment        string
+	Tags           []*StructTag // struct tags of the entity field besides db
+	Cast           string       // SQL type condition values are cast to, e.g. DECIMAL(10,2)
+	NullKind       NullKind     // representation of NULL, empty for NOT NULL columns
+	IsJSON         bool
 	IsPk           bool
 	HasIndex       bool
+	Policy         ColumnPolicy // write operations which may set the column
+	AutoGenerated  bool         // MySQL generates the value if an insert omits it
 }
 
 func main() {
@@ -62,64 +69,75 @@ func main() {
 	}
 	defer mysqlDB.Close()
 	slog.Info("get tables")
-	tableRows, err := mysqlDB.Query("show tables;")
+	databases, err := getDatabases(ctx)
 	if err != nil {
-		slog.Error("error: exec `show tables` failed", "error", err)
+		slog.Error("error: get databases failed", "error", err)
 		os.Exit(1)
 	}
-	defer tableRows.Close()
-	tableIndex := make(map[string]struct{})
+	var tables []*TableEntity
 	shadowTables := make(map[string]string)
-	for tableRows.Next() {
-		var table string
-		tableRows.Scan(&table)
-		tableIndex[table] = struct{}{}
-	}
-	var tables []string
-	for index := range tableIndex {
-		var table string
-		if strings.HasPrefix(index, shadowPrefix) {
-			table = strings.TrimPrefix(index, shadowPrefix)
-		} else if strings.HasSuffix(index, shadowSuffix) {
-			table = strings.TrimSuffix(index, shadowSuffix)
-		} else {
-			tables = append(tables, index)
-			continue
+	for _, database := range databases {
+		dbTables, dbShadowTables, err := getTables(ctx, database)
+		if err != nil {
+			slog.Error(fmt.Sprintf("error: exec `show tables` in database %s failed", database), "error", err)
+			os.Exit(1)
 		}
-		if _, ok := tableIndex[table]; !ok {
-			tables = append(tables, index)
-			continue
+		for _, table := range dbTables {
+			tables = append(tables, &TableEntity{Database: database, Name: table, Ident: table})
+		}
+		for shadow, table := range dbShadowTables {
+			shadowTables[shadow] = table
 		}
-		shadowTables[index] = table
 	}
 	if len(tables) == 0 {
 		slog.Error("error: no tables be found in database")
 		os.Exit(1)
 	}
-	slog.Info("gen dao.go")
 	pkg := filepath.Base(outputDir)
-	err = genInitDao(ctx, pkg, shadowTables)
+	err = generate(ctx, pkg, tables, shadowTables, getTableSchema)
 	if err != nil {
-		println(err.Error())
+		slog.Error(err.Error())
+		os.Exit(1)
 	}
-	slog.Info("gen tables")
-	for _, table := range tables {
-		if len(tablesMap) != 0 {
-			if _, ok := tablesMap[table]; !ok {
-				continue
-			}
+}
+
+// schemaLoader returns the columns and indexes of a table.
+type schemaLoader func(ctx context.Context, table *TableEntity) ([]*ColumnEntity, []*IndexEntityV5, error)
+
+// generate generates the files of the package pkg for the tables into outputDir.
+// With -verify, the files are only written if the generated package has no type errors.
+// No file is generated if the identifiers declared for the tables collide, see checkDeclarations.
+func generate(ctx context.Context, pkg string, tables []*TableEntity, shadowTables map[string]string, loadSchema schemaLoader) error {
+	tables, err := filterTables(tables)
+	if err != nil {
+		return err
+	}
+	if shard {
+		if tables, err = groupShardTables(tables); err != nil {
+			return err
 		}
-		columns, err := getTableColumns(ctx, table)
-		if err != nil {
-			slog.Error("Get table columns failed", "error", err)
-			continue
+	}
+	tableNames := make(map[string]int)
+	for _, table := range tables {
+		tableNames[table.Name]++
+	}
+	// Tables with the same name in several databases are identified by database_table
+	for _, table := range tables {
+		if tableNames[table.Name] > 1 {
+			table.Ident = table.Database + "_" + table.Name
 		}
-		indexes, err := getTableIndexes(ctx, table)
+	}
+	// The render data of all the tables are collected first, which are related by their foreign keys
+	var generated []*TableEntity
+	var rDataList []*RenderData
+	var importsList [][]string
+	for _, tableEntity := range tables {
+		columns, indexes, err := loadSchema(ctx, tableEntity)
 		if err != nil {
-			slog.Error("Get table indexes failed", "error", err)
+			slog.Error("Get table schema failed", "error", err)
 			continue
 		}
-		rData, imports, err := getRenderData(ctx, pkg, table, columns, indexes)
+		rData, imports, err := getRenderData(ctx, pkg, tableEntity, columns, indexes)
 		if err != nil {
 			slog.Error("Get render data failed", "error", err)
 			continue
@@ -127,6 +145,39 @@ func main() {
 		if rData == nil {
 			continue
 		}
+		generated = append(generated, tableEntity)
+		rDataList = append(rDataList, rData)
+		importsList = append(importsList, imports)
+	}
+	linkRelations(generated, rDataList)
+	if err := checkDeclarations(pkg, rDataList); err != nil {
+		return err
+	}
+	slog.Info("gen dao.go")
+	err = genInitDao(ctx, pkg, shadowTables)
+	if err != nil {
+		println(err.Error())
+	}
+	if tracing {
+		slog.Info("gen tracing.go")
+		err = genTracing(ctx, pkg)
+		if err != nil {
+			println(err.Error())
+		}
+	}
+	if metrics {
+		slog.Info("gen metrics.go")
+		err = genMetrics(ctx, pkg)
+		if err != nil {
+			println(err.Error())
+		}
+	}
+	slog.Info("gen tables")
+	var types SharedTypes
+	for i, tableEntity := range generated {
+		table := tableEntity.Ident
+		rData, imports := rDataList[i], importsList[i]
+		types = types.Merge(rData.Types)
 		slog.Info(fmt.Sprintf("gen table %s \n", table))
 		err = genTable(ctx, table, rData, imports)
 		if err != nil {
@@ -139,7 +190,162 @@ func main() {
 			println(err.Error())
 			continue
 		}
+		slog.Info(fmt.Sprintf("gen table repository %s \n", table))
+		err = genTableRepository(ctx, table, rData)
+		if err != nil {
+			println(err.Error())
+			continue
+		}
+		if genTests {
+			if rData.Test == nil {
+				slog.Warn(fmt.Sprintf("skip test of table %s, it has no primary key or no column identifying the fixture rows", table))
+				continue
+			}
+			slog.Info(fmt.Sprintf("gen table test %s \n", table))
+			err = genTableTest(ctx, table, rData)
+			if err != nil {
+				println(err.Error())
+				continue
+			}
+		}
+	}
+	if types.Any() {
+		slog.Info("gen types.go")
+		err = genTypes(ctx, pkg, types)
+		if err != nil {
+			println(err.Error())
+		}
+	}
+	if genTests {
+		slog.Info("gen dao_test.go")
+		var databases []string
+		for _, tableEntity := range generated {
+			names := []string{tableEntity.Database}
+			for _, item := range tableEntity.Shards {
+				names = append(names, item.Database)
+			}
+			for _, name := range names {
+				if !slices.Contains(databases, name) {
+					databases = append(databases, name)
+				}
+			}
+		}
+		err = genInitDaoTest(ctx, pkg, types, databases)
+		if err != nil {
+			println(err.Error())
+		}
+	}
+	if verify {
+		slog.Info("verify generated package")
+		return writePendingFiles()
+	}
+	return nil
+}
+
+// TableEntity represents a table to generate.
+type TableEntity struct {
+	Database string
+	Name     string
+	Ident    string // base name of the Go identifiers and files, database_table if Name is ambiguous
+	Comment  string
+	Shards   []*ShardEntity // physical tables of a sharded table, ordered by table suffix
+	// ForeignKeys lists the columns of the FOREIGN KEY constraints of the table
+	ForeignKeys []*ForeignKeyEntity
+}
+
+// QuotedName returns the quoted name of the table qualified by its database,
+// the first physical table for a sharded table.
+func (t *TableEntity) QuotedName() string {
+	if len(t.Shards) != 0 {
+		return (&TableEntity{Database: t.Shards[0].Database, Name: t.Shards[0].Name}).QuotedName()
+	}
+	if t.Database == "" {
+		return "`" + t.Name + "`"
+	}
+	return "`" + t.Database + "`.`" + t.Name + "`"
+}
+
+// getDatabases returns the databases to generate, the -databases list or the database of the connection.
+func getDatabases(ctx context.Context) (databases []string, err error) {
+	if len(databaseList) != 0 {
+		return databaseList, nil
+	}
+	var database sql.NullString
+	err = mysqlDB.QueryRowContext(ctx, "select database();").Scan(&database)
+	if err != nil {
+		return
+	}
+	return []string{database.String}, nil
+}
+
+// getTables returns the tables of the database and the shadow tables mapping to them.
+func getTables(ctx context.Context, database string) (tables []string, shadowTables map[string]string, err error) {
+	query := "show tables;"
+	if database != "" {
+		query = fmt.Sprintf("show tables from `%s`;", database)
+	}
+	tableRows, err := mysqlDB.QueryContext(ctx, query)
+	if err != nil {
+		return
+	}
+	defer tableRows.Close()
+	tableIndex := make(map[string]struct{})
+	shadowTables = make(map[string]string)
+	for tableRows.Next() {
+		var table string
+		tableRows.Scan(&table)
+		tableIndex[table] = struct{}{}
+	}
+	for index := range tableIndex {
+		var table string
+		if strings.HasPrefix(index, shadowPrefix) {
+			table = strings.TrimPrefix(index, shadowPrefix)
+		} else if strings.HasSuffix(index, shadowSuffix) {
+			table = strings.TrimSuffix(index, shadowSuffix)
+		} else {
+			tables = append(tables, index)
+			continue
+		}
+		if _, ok := tableIndex[table]; !ok {
+			tables = append(tables, index)
+			continue
+		}
+		shadowTables[index] = table
+	}
+	slices.Sort(tables)
+	return
+}
+
+// getTableSchema returns the columns and indexes of a table from the database.
+func getTableSchema(ctx context.Context, table *TableEntity) (columns []*ColumnEntity, indexes []*IndexEntityV5, err error) {
+	columns, err = getTableColumns(ctx, table)
+	if err != nil {
+		return
+	}
+	indexes, err = getTableIndexes(ctx, table)
+	if err != nil {
+		return
+	}
+	table.Comment, err = getTableComment(ctx, table)
+	if err != nil {
+		return
+	}
+	table.ForeignKeys, err = getTableForeignKeys(ctx, table)
+	return
+}
+
+// getTableComment returns the comment of a table, of the first physical table for a sharded table.
+func getTableComment(ctx context.Context, table *TableEntity) (comment string, err error) {
+	database, name := table.Database, table.Name
+	if len(table.Shards) != 0 {
+		database, name = table.Shards[0].Database, table.Shards[0].Name
+	}
+	err = mysqlDB.QueryRowContext(ctx, "select table_comment from information_schema.tables "+
+		"where table_schema = coalesce(nullif(?, ''), database()) and table_name = ?;", database, name).Scan(&comment)
+	if err != nil {
+		return "", fmt.Errorf("error: failed to get comment of table %s, %v", table.Name, err)
 	}
+	return
 }
 
 const (
@@ -169,10 +375,10 @@ type IndexEntityV8 struct {
 	Expression sql.NullString
 }
 
-func getTableIndexes(ctx context.Context, table string) (indexes []*IndexEntityV5, err error) {
-	indexRows, err := mysqlDB.Query(fmt.Sprintf("show index from `%s`", table))
+func getTableIndexes(ctx context.Context, table *TableEntity) (indexes []*IndexEntityV5, err error) {
+	indexRows, err := mysqlDB.Query(fmt.Sprintf("show index from %s", table.QuotedName()))
 	if err != nil {
-		return indexes, fmt.Errorf("error:  failed to get indexes from table %s, %v", table, err)
+		return indexes, fmt.Errorf("error:  failed to get indexes from table %s, %v", table.Name, err)
 	}
 	defer indexRows.Close()
 	cols, _ := indexRows.Columns()
@@ -216,10 +422,10 @@ type ColumnEntity struct {
 	Comment    string
 }
 
-func getTableColumns(ctx context.Context, table string) (columns []*ColumnEntity, err error) {
-	columnRows, err := mysqlDB.Query(fmt.Sprintf("show full columns from `%s`", table))
+func getTableColumns(ctx context.Context, table *TableEntity) (columns []*ColumnEntity, err error) {
+	columnRows, err := mysqlDB.Query(fmt.Sprintf("show full columns from %s", table.QuotedName()))
 	if err != nil {
-		return columns, fmt.Errorf("error:  failed to get full columns from table %s, %v", table, err)
+		return columns, fmt.Errorf("error:  failed to get full columns from table %s, %v", table.Name, err)
 	}
 	defer columnRows.Close()
 	for columnRows.Next() {
@@ -231,37 +437,53 @@ func getTableColumns(ctx context.Context, table string) (columns []*ColumnEntity
 	return
 }
 
-func getRenderData(ctx context.Context, pkg, table string,
+func getRenderData(ctx context.Context, pkg string, tableEntity *TableEntity,
 	columns []*ColumnEntity, indexes []*IndexEntityV5) (rData *RenderData, imports []string, err error) {
+	tableDirectives, err := parseDirectives(tableEntity.Comment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error: table %s, %v", tableEntity.Name, err)
+	}
+	if tableDirectives.Type != "" || len(tableDirectives.Enum) != 0 || len(tableDirectives.Tags) != 0 {
+		return nil, nil, fmt.Errorf("error: table %s, only @exclude and the policy directives apply to tables", tableEntity.Name)
+	}
+	if tableDirectives.Exclude {
+		return
+	}
+	if columns, indexes, err = filterColumns(tableEntity, columns, indexes); err != nil {
+		return nil, nil, err
+	}
 	if len(columns) == 0 {
 		return
 	}
+	table := tableEntity.Ident
 	attrs := make([]*AttrEntity, 0, len(columns))
 	var primary string
 	var timeFields TimeFields
+	var types SharedTypes
+	var enums []*EnumEntity
+	var constraints []*ConstraintEntity
 	importsMap := make(map[string]struct{})
 	for _, column := range columns {
 		nullable := false
 		if column.Null == "YES" {
 			nullable = true
 		}
-		dt := convertDatabaseTypeToGoType(column.Type, nullable)
-		if strings.HasPrefix(dt, "sql.") {
-			if _, ok := importsMap["database/sql"]; !ok {
-				importsMap["database/sql"] = struct{}{}
-				imports = append(imports, "database/sql")
-			}
+		directives, err := parseDirectives(column.Comment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: column %s.%s, %v", table, column.Field, err)
 		}
-		if strings.HasPrefix(dt, "time.") {
-			if _, ok := importsMap["time"]; !ok {
-				importsMap["time"] = struct{}{}
-				imports = append(imports, "time")
-			}
+		dt, err := convertColumnToGoType(table, column, directives)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: column %s.%s, %v", table, column.Field, err)
+		}
+		typeImports := getTypeImports(dt)
+		if directives.Import != "" {
+			typeImports = append(typeImports, directives.Import)
 		}
-		if strings.HasPrefix(dt, "decimal.") {
-			if _, ok := importsMap["github.com/shopspring/decimal"]; ok {
-				importsMap["github.com/shopspring/decimal"] = struct{}{}
-				imports = append(imports, "github.com/shopspring/decimal")
+		for _, importPath := range typeImports {
+			if _, ok := importsMap[importPath]; !ok {
+				importsMap[importPath] = struct{}{}
+				imports = append(imports, importPath)
 			}
 		}
 		var hasIndex, isPk bool
@@ -272,18 +494,41 @@ func getRenderData(ctx context.Context, pkg, table string,
 				primary = column.Field
 			}
 		}
+		policy, autoGenerated := getColumnPolicy(tableEntity, column, cmp.Or(directives.Policy, tableDirectives.Policy))
 		attr := &AttrEntity{
 			Name:           initialisms.SnakeToCamelIdentifier(column.Field),
 			NameCamel:      replaceReserved(initialisms.ForceLowerCamelIdentifier(column.Field)),
 			NameCamelIdent: initialisms.ForceCamelIdentifier(column.Field),
 			Type:           dt,
 			Tag:            column.Field,
-			Comment:        column.Comment,
+			Comment:        directives.Comment,
+			Cast:           convertDatabaseTypeToCast(column.Type),
+			NullKind:       getNullKind(dt, nullable),
+			IsJSON:         getBaseType(column.Type) == "json",
 			IsPk:           isPk,
 			HasIndex:       hasIndex,
+			Policy:         policy,
+			AutoGenerated:  autoGenerated,
 		}
+		attr.Tags = getStructTags(column, attr, directives)
 		attrs = append(attrs, attr)
-		if _, ok := createTimeMap[column.Field]; ok {
+		if attr.IsJSON {
+			types.JSONCond = true
+		}
+		if enum := getEnum(table, column, directives); enum != nil {
+			enums = append(enums, enum)
+		}
+		if constraint := getConstraint(column, attr, directives); constraint != nil {
+			constraints = append(constraints, constraint)
+		}
+		types = types.Merge(SharedTypes{
+			JSON:     containsType(dt, "JSON"),
+			Geometry: containsType(dt, "Geometry"),
+			Duration: containsType(dt, "Duration"),
+			Bits:     containsType(dt, "Bits"),
+		})
+		// The DAO sets the create time on insert and the update time on every write, unless the policy forbids it
+		if _, ok := createTimeMap[column.Field]; ok && policy != ColumnPolicyReadOnly {
 			var timeType TimeType
 			columnType := strings.ToLower(column.Type)
 			columnType = strings.SplitN(columnType, " ", 2)[0]
@@ -300,7 +545,7 @@ func getRenderData(ctx context.Context, pkg, table string,
 			timeFields.CreateType = timeType
 			continue
 		}
-		if _, ok := updateTimeMap[column.Field]; ok {
+		if _, ok := updateTimeMap[column.Field]; ok && policy == ColumnPolicyWritable {
 			var timeType TimeType
 			columnType := strings.ToLower(column.Type)
 			columnType = strings.SplitN(columnType, " ", 2)[0]
@@ -317,6 +562,23 @@ func getRenderData(ctx context.Context, pkg, table string,
 			timeFields.UpdateType = timeType
 		}
 	}
+	// Inserts need not set the time fields, which the DAO sets
+	for _, constraint := range constraints {
+		if constraint.Column == timeFields.CreateTime || constraint.Column == timeFields.UpdateTime {
+			constraint.Required = false
+		}
+	}
+	shardData, err := getShardData(tableEntity, attrs, primary)
+	if err != nil {
+		return nil, nil, err
+	}
+	var testData *TestData
+	if genTests && primary != "" {
+		testData, err = getTestData(table, columns, attrs, timeFields, shardData)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 	idxs := make(Indexes)
 	for _, index := range indexes {
 		if index.NonUnique {
@@ -326,13 +588,20 @@ func getRenderData(ctx context.Context, pkg, table string,
 	}
 	rData = &RenderData{
 		Pkg:                  pkg,
-		Table:                table,
+		Database:             tableEntity.Database,
+		Table:                tableEntity.Name,
+		Comment:              tableDirectives.Comment,
 		TableLowerCamelIdent: initialisms.ForceLowerCamelIdentifier(table),
 		TableUpperCamelIdent: initialisms.ForceCamelIdentifier(table),
 		Primary:              primary,
 		Attrs:                attrs,
 		UniqueIndexes:        idxs,
 		TimeFields:           timeFields,
+		Types:                types,
+		Enums:                enums,
+		Constraints:          constraints,
+		Shard:                shardData,
+		Test:                 testData,
 	}
 	return
 }
@@ -344,19 +613,83 @@ func genTable(ctx context.Context, table string, rData *RenderData, imports []st
 	if !slices.Contains(imports, "time") && (rData.TimeFields.CreateTime != "" || rData.TimeFields.UpdateTime != "") {
 		imports = append(imports, "time")
 	}
+	// The ENUM and SET types implement driver.Valuer, the SET types parse their members with strings,
+	// and the @enum types of integer columns need neither
+	if slices.ContainsFunc(rData.Enums, func(enum *EnumEntity) bool { return enum.Underlying == "" }) &&
+		!slices.Contains(imports, "database/sql/driver") {
+		imports = append(imports, "database/sql/driver")
+	}
+	if slices.ContainsFunc(rData.Enums, func(enum *EnumEntity) bool { return enum.IsSet }) &&
+		!slices.Contains(imports, "strings") {
+		imports = append(imports, "strings")
+	}
 	rData.Imports = imports
 	content, err := renderTable(table, rData)
 	if err != nil {
 		return fmt.Errorf("error: render table %s tpl failed, %v", table, err)
 	}
-	f, err := getTableFile(table)
+	return writeFile(&pendingFile{
+		name:     strings.Replace(table, "_", "", -1) + ".go",
+		template: "table.tpl",
+		data:     rData,
+		content:  content,
+		write: func() error {
+			f, err := getTableFile(table)
+			if err != nil {
+				return fmt.Errorf("error: generate table %s file failed, %v", table, err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genTableRepository(ctx context.Context, table string, rData *RenderData) error {
+	content, err := renderTableRepository(table, rData)
+	if err != nil {
+		return fmt.Errorf("error: render table %s repository tpl failed, %v", table, err)
+	}
+	return writeFile(&pendingFile{
+		name:     strings.Replace(table, "_", "", -1) + "repository.go",
+		template: "repository.tpl",
+		data:     rData,
+		content:  content,
+		write: func() error {
+			f, err := getTableRepositoryFile(table)
+			if err != nil {
+				return fmt.Errorf("error: generate table %s repository file failed, %v", table, err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genTableTest(ctx context.Context, table string, rData *RenderData) error {
+	content, err := renderTableTest(table, rData)
 	if err != nil {
-		return fmt.Errorf("error: generate table %s file failed, %v", table, err)
+		return fmt.Errorf("error: render table %s test tpl failed, %v", table, err)
 	}
+	return writeFile(&pendingFile{
+		name:     strings.Replace(table, "_", "", -1) + "_dao_test.go",
+		template: "table_test.tpl",
+		data:     rData,
+		content:  content,
+		write: func() error {
+			f, err := getTableTestFile(table)
+			if err != nil {
+				return fmt.Errorf("error: generate table %s test file failed, %v", table, err)
+			}
 
-	f.Write(content)
-	f.Close()
-	return nil
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
 }
 
 func genTableConds(ctx context.Context, table string, rData *RenderData, imports []string) error {
@@ -365,35 +698,167 @@ func genTableConds(ctx context.Context, table string, rData *RenderData, imports
 	if err != nil {
 		return fmt.Errorf("error: render table %s conds tpl failed, %v", table, err)
 	}
-	f, err := getTableCondsFile(table)
+	return writeFile(&pendingFile{
+		name:     strings.Replace(table, "_", "", -1) + "conds.go",
+		template: "conds.tpl",
+		data:     rData,
+		content:  content,
+		write: func() error {
+			f, err := getTableCondsFile(table)
+			if err != nil {
+				return fmt.Errorf("error: generate table %s conds file failed, %v", table, err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genTypes(ctx context.Context, pkg string, types SharedTypes) error {
+	renderData := &RenderData{
+		Pkg:   pkg,
+		Types: types,
+	}
+	content, err := renderTypes(renderData)
 	if err != nil {
-		return fmt.Errorf("error: generate table %s conds file failed, %v", table, err)
+		return fmt.Errorf("error: render types tpl failed, %v", err)
 	}
+	return writeFile(&pendingFile{
+		name:     "types.go",
+		template: "types.tpl",
+		content:  content,
+		write: func() error {
+			f, err := getTypesFile()
+			if err != nil {
+				if err == ErrFileAlreadyExists {
+					return err
+				}
+				return fmt.Errorf("error: generate types file failed, %v", err)
+			}
 
-	f.Write(content)
-	f.Close()
-	return nil
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genInitDaoTest(ctx context.Context, pkg string, types SharedTypes, databases []string) error {
+	renderData := &RenderData{
+		Pkg:       pkg,
+		Types:     types,
+		Databases: databases,
+		Metrics:   metrics,
+	}
+	content, err := renderInitDaoTest(renderData)
+	if err != nil {
+		return fmt.Errorf("error: render dao test tpl failed, %v", err)
+	}
+	return writeFile(&pendingFile{
+		name:     "dao_test.go",
+		template: "dao_test.tpl",
+		content:  content,
+		write: func() error {
+			f, err := getInitDaoTestFile()
+			if err != nil {
+				if err == ErrFileAlreadyExists {
+					return err
+				}
+				return fmt.Errorf("error: generate dao test file failed, %v", err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genTracing(ctx context.Context, pkg string) error {
+	renderData := &RenderData{
+		Pkg: pkg,
+	}
+	content, err := renderTracing(renderData)
+	if err != nil {
+		return fmt.Errorf("error: render tracing tpl failed, %v", err)
+	}
+	return writeFile(&pendingFile{
+		name:     "tracing.go",
+		template: "tracing.tpl",
+		content:  content,
+		write: func() error {
+			f, err := getTracingFile()
+			if err != nil {
+				if err == ErrFileAlreadyExists {
+					return err
+				}
+				return fmt.Errorf("error: generate tracing file failed, %v", err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
+}
+
+func genMetrics(ctx context.Context, pkg string) error {
+	renderData := &RenderData{
+		Pkg: pkg,
+	}
+	content, err := renderMetrics(renderData)
+	if err != nil {
+		return fmt.Errorf("error: render metrics tpl failed, %v", err)
+	}
+	return writeFile(&pendingFile{
+		name:     "metrics.go",
+		template: "metrics.tpl",
+		content:  content,
+		write: func() error {
+			f, err := getMetricsFile()
+			if err != nil {
+				if err == ErrFileAlreadyExists {
+					return err
+				}
+				return fmt.Errorf("error: generate metrics file failed, %v", err)
+			}
+
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
 }
 
 func genInitDao(ctx context.Context, pkg string, shadowTables map[string]string) error {
 	renderData := &RenderData{
 		Pkg:          pkg,
 		ShadowTables: shadowTables,
+		Sharding:     shard,
 	}
 	content, err := renderInitDao(renderData)
 	if err != nil {
 		return fmt.Errorf("error: render dao tpl failed, %v", err)
 	}
-	f, err := getInitDaoFile()
-	if err != nil {
-		// if dao.go file already exist, return directly
-		if err == ErrFileAlreadyExists {
-			return err
-		}
-		return fmt.Errorf("error: generate dao file failed, %v", err)
-	}
+	return writeFile(&pendingFile{
+		name:     "dao.go",
+		template: "dao.tpl",
+		content:  content,
+		write: func() error {
+			f, err := getInitDaoFile()
+			if err != nil {
+				// if dao.go file already exist, return directly
+				if err == ErrFileAlreadyExists {
+					return err
+				}
+				return fmt.Errorf("error: generate dao file failed, %v", err)
+			}
 
-	f.Write(content)
-	f.Close()
-	return nil
+			f.Write(content)
+			f.Close()
+			return nil
+		},
+	})
 }
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the generated code")
+
+// goldenSchema describes the tables of a golden test case and the flags to generate them with.
+type goldenSchema struct {
+	Options      goldenOptions
+	ShadowTables map[string]string
+	Tables       []struct {
+		Database    string
+		Name        string
+		Comment     string
+		Columns     []*ColumnEntity
+		Indexes     []*IndexEntityV5
+		ForeignKeys []*ForeignKeyEntity
+	}
+}
+
+type goldenOptions struct {
+	Decimal   string
+	Null      string
+	JSON      string
+	JSONTypes map[string]string
+	ExactInt  bool
+	Enum      bool
+	Tracing   bool
+	Metrics   bool
+	Shard     bool
+	ShardKeys map[string]string
+	GenTests  bool
+	Verify    bool
+	Tables    []string
+
+	ExcludeColumns  []string
+	ReadOnlyColumns []string
+	ColumnPolicies  []string
+	Tags            []string
+}
+
+// apply sets the flag vars of the options, the defaults of parseFlags for the others.
+func (o goldenOptions) apply() {
+	decimalMode = DecimalMode(cmp.Or(o.Decimal, string(DecimalModeFloat)))
+	nullMode = NullMode(cmp.Or(o.Null, string(NullModeSQL)))
+	jsonMode = JSONMode(cmp.Or(o.JSON, string(JSONModeString)))
+	jsonTypes = make(map[string]string)
+	maps.Copy(jsonTypes, o.JSONTypes)
+	exactInt = o.ExactInt
+	enumTypes = o.Enum
+	tracing = o.Tracing
+	metrics = o.Metrics
+	shard = o.Shard
+	shardKeys = make(map[string]string)
+	maps.Copy(shardKeys, o.ShardKeys)
+	genTests = o.GenTests
+	verify = o.Verify
+	includedTables, excludedTables, _ = parseTablePatterns(strings.Join(o.Tables, ","))
+	excludedColumns, _ = parseColumnPatterns(strings.Join(o.ExcludeColumns, ","))
+	readOnlyColumns, _ = parseColumnPatterns(strings.Join(o.ReadOnlyColumns, ","))
+	columnPolicies, _ = parseColumnPolicies(strings.Join(o.ColumnPolicies, ","))
+	structTags, _ = parseStructTags(strings.Join(o.Tags, ","))
+}
+
+// TestGenerate generates the package of every schema in testdata/schemas, compares the files with
+// the golden files in testdata/golden, and type checks them. Run with -update to update the golden files.
+func TestGenerate(t *testing.T) {
+	schemaFiles, err := filepath.Glob(filepath.Join("testdata", "schemas", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schemaFiles) == 0 {
+		t.Fatal("no schemas found in testdata/schemas")
+	}
+	imp := newStubImporter(filepath.Join("testdata", "stubs"))
+	for _, schemaFile := range schemaFiles {
+		name := strings.TrimSuffix(filepath.Base(schemaFile), ".json")
+		t.Run(name, func(t *testing.T) {
+			files := generateSchema(t, schemaFile)
+			goldenDir := filepath.Join("testdata", "golden", name)
+			if *update {
+				writeGolden(t, goldenDir, files)
+			}
+			compareGolden(t, goldenDir, files)
+			typeCheck(t, imp, files)
+		})
+	}
+}
+
+func TestGetRenderData(t *testing.T) {
+	goldenOptions{}.apply()
+	table := &TableEntity{Database: "shop", Name: "user_orders", Ident: "shop_user_orders"}
+	columns := []*ColumnEntity{
+		{Field: "id", Type: "bigint", Null: "NO", Key: "PRI", Extra: "auto_increment"},
+		{Field: "type", Type: "varchar(16)", Null: "YES", Key: "MUL"},
+		{Field: "amount", Type: "decimal(10,2)", Null: "NO"},
+		{Field: "created_on", Type: "date", Null: "NO"},
+		{Field: "ctime", Type: "int", Null: "NO"},
+		{Field: "updated_at", Type: "timestamp", Null: "YES"},
+	}
+	indexes := []*IndexEntityV5{
+		{KeyName: "PRIMARY", ColumnName: "id"},
+		{KeyName: "type_amount_uk", SeqInIndex: 1, ColumnName: "type"},
+		{KeyName: "type_amount_uk", SeqInIndex: 2, ColumnName: "amount"},
+		{NonUnique: true, KeyName: "type_idx", ColumnName: "type"},
+	}
+	rData, imports, err := getRenderData(context.Background(), "dao", table, columns, indexes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rData.Table != "user_orders" || rData.TableUpperCamelIdent != "ShopUserOrders" || rData.TableLowerCamelIdent != "shopUserOrders" {
+		t.Errorf("table = %s %s %s, want user_orders ShopUserOrders shopUserOrders", rData.Table, rData.TableUpperCamelIdent, rData.TableLowerCamelIdent)
+	}
+	if rData.Primary != "id" {
+		t.Errorf("Primary = %q, want id", rData.Primary)
+	}
+	wantAttrs := []struct{ name, nameCamel, typ string }{
+		{"ID", "id", "int64"},
+		{"Type", "typeReserved", "sql.NullString"},
+		{"Amount", "amount", "float64"},
+		{"CreatedOn", "createdOn", "time.Time"},
+		{"Ctime", "ctime", "int"},
+		{"UpdatedAt", "updatedAt", "sql.NullTime"},
+	}
+	if len(rData.Attrs) != len(wantAttrs) {
+		t.Fatalf("got %d attrs, want %d", len(rData.Attrs), len(wantAttrs))
+	}
+	for i, want := range wantAttrs {
+		attr := rData.Attrs[i]
+		if attr.Name != want.name || attr.NameCamel != want.nameCamel || attr.Type != want.typ {
+			t.Errorf("attr %d = %s %s %s, want %s %s %s", i, attr.Name, attr.NameCamel, attr.Type, want.name, want.nameCamel, want.typ)
+		}
+	}
+	if !rData.Attrs[0].IsPk || !rData.Attrs[1].HasIndex || rData.Attrs[2].HasIndex {
+		t.Errorf("IsPk or HasIndex of the attrs are wrong")
+	}
+	// Decimals mapped to float64 are compared as numbers without a cast
+	if rData.Attrs[2].Cast != "" {
+		t.Errorf("Cast of amount = %q, want none", rData.Attrs[2].Cast)
+	}
+	wantIndexes := Indexes{"PRIMARY": {"id"}, "type_amount_uk": {"type", "amount"}}
+	if !maps.EqualFunc(rData.UniqueIndexes, wantIndexes, slices.Equal) {
+		t.Errorf("UniqueIndexes = %v, want %v", rData.UniqueIndexes, wantIndexes)
+	}
+	// created_on is a date, so the int ctime is the create time
+	wantTimeFields := TimeFields{CreateTime: "ctime", CreateType: TimeTypeInt, UpdateTime: "updated_at", UpdateType: TimeTypeDatetime}
+	if rData.TimeFields != wantTimeFields {
+		t.Errorf("TimeFields = %+v, want %+v", rData.TimeFields, wantTimeFields)
+	}
+	if !slices.Equal(imports, []string{"database/sql", "time"}) {
+		t.Errorf("imports = %v, want [database/sql time]", imports)
+	}
+
+	_, _, err = getRenderData(context.Background(), "dao", table, []*ColumnEntity{{Field: "v", Type: "vector(3)", Null: "NO"}}, nil)
+	if err == nil {
+		t.Errorf("getRenderData() of an unsupported column returns no error")
+	}
+}
+
+// generateSchema generates the package of the schema file, and returns the generated files.
+func generateSchema(t *testing.T, schemaFile string) map[string][]byte {
+	t.Helper()
+	content, err := os.ReadFile(schemaFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema goldenSchema
+	if err = json.Unmarshal(content, &schema); err != nil {
+		t.Fatalf("parse %s failed, %v", schemaFile, err)
+	}
+	schema.Options.apply()
+	outputDir = t.TempDir()
+	var tables []*TableEntity
+	for _, table := range schema.Tables {
+		tables = append(tables, &TableEntity{Database: table.Database, Name: table.Name, Ident: table.Name})
+	}
+	loadSchema := func(ctx context.Context, table *TableEntity) ([]*ColumnEntity, []*IndexEntityV5, error) {
+		database, name := table.Database, table.Name
+		if len(table.Shards) != 0 {
+			database, name = table.Shards[0].Database, table.Shards[0].Name
+		}
+		for _, item := range schema.Tables {
+			if item.Database == database && item.Name == name {
+				table.Comment, table.ForeignKeys = item.Comment, item.ForeignKeys
+				return item.Columns, item.Indexes, nil
+			}
+		}
+		t.Fatalf("table %s.%s not found in %s", database, name, schemaFile)
+		return nil, nil, nil
+	}
+	shadowTables := make(map[string]string)
+	maps.Copy(shadowTables, schema.ShadowTables)
+	if err = generate(context.Background(), "dao", tables, shadowTables, loadSchema); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(outputDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(outputDir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[entry.Name()] = content
+	}
+	return files
+}
+
+// writeGolden replaces the golden files in dir by the generated files.
+func writeGolden(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name+".golden"), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// compareGolden compares the generated files with the golden files in dir.
+func compareGolden(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+	goldenFiles, err := filepath.Glob(filepath.Join(dir, "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := make(map[string]struct{}, len(goldenFiles))
+	for _, goldenFile := range goldenFiles {
+		name := strings.TrimSuffix(filepath.Base(goldenFile), ".golden")
+		golden[name] = struct{}{}
+		want, err := os.ReadFile(goldenFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := files[name]
+		if !ok {
+			t.Errorf("%s is not generated", name)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs from %s at line %d, run go test -update to update the golden files", name, goldenFile, diffLine(got, want))
+		}
+	}
+	for name := range files {
+		if _, ok := golden[name]; !ok {
+			t.Errorf("%s is generated but has no golden file in %s", name, dir)
+		}
+	}
+}
+
+// diffLine returns the first line number where got and want differ.
+func diffLine(got, want []byte) int {
+	gotLines, wantLines := bytes.Split(got, []byte("\n")), bytes.Split(want, []byte("\n"))
+	for i := range min(len(gotLines), len(wantLines)) {
+		if !bytes.Equal(gotLines[i], wantLines[i]) {
+			return i + 1
+		}
+	}
+	return min(len(gotLines), len(wantLines)) + 1
+}
+
+// typeCheck type checks the generated files as one package.
+func typeCheck(t *testing.T, imp types.Importer, files map[string][]byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	var astFiles []*ast.File
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, name, files[name], 0)
+		if err != nil {
+			t.Errorf("parse %s failed, %v", name, err)
+			return
+		}
+		astFiles = append(astFiles, file)
+	}
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			t.Errorf("type check failed, %v", err)
+		},
+	}
+	conf.Check("dao", fset, astFiles, nil)
+}
+
+// stubImporter imports the packages stubbed in its directory from their sources,
+// and the others, i.e. the standard library and the modules of go.mod, by the source importer.
+type stubImporter struct {
+	dir      string
+	fset     *token.FileSet
+	packages map[string]*types.Package
+	fallback types.Importer
+}
+
+func newStubImporter(dir string) *stubImporter {
+	fset := token.NewFileSet()
+	return &stubImporter{
+		dir:      dir,
+		fset:     fset,
+		packages: make(map[string]*types.Package),
+		fallback: importer.ForCompiler(fset, "source", nil),
+	}
+}
+
+// Import implements types.Importer.
+func (s *stubImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := s.packages[path]; ok {
+		return pkg, nil
+	}
+	dir := filepath.Join(s.dir, filepath.FromSlash(path))
+	if _, err := os.Stat(dir); err != nil {
+		return s.fallback.Import(path)
+	}
+	sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, source := range sources {
+		file, err := parser.ParseFile(s.fset, source, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	conf := types.Config{Importer: s}
+	pkg, err := conf.Check(path, s.fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.packages[path] = pkg
+	return pkg, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ColumnPolicy specifies which write operations of the DAO may set a column.
+type ColumnPolicy string
+
+const (
+	// ColumnPolicyWritable columns are written by Insert, InsertMany and Update.
+	ColumnPolicyWritable ColumnPolicy = "writable"
+	// ColumnPolicyInsertOnly columns are written by Insert and InsertMany, but not Update.
+	ColumnPolicyInsertOnly ColumnPolicy = "insertonly"
+	// ColumnPolicyReadOnly columns are never written by the DAO.
+	ColumnPolicyReadOnly ColumnPolicy = "readonly"
+)
+
+// Const returns the name of the ColumnPolicy constant of the policy in the generated package.
+func (p ColumnPolicy) Const() string {
+	switch p {
+	case ColumnPolicyInsertOnly:
+		return "ColumnInsertOnly"
+	case ColumnPolicyReadOnly:
+		return "ColumnReadOnly"
+	}
+	return "ColumnWritable"
+}
+
+// columnPolicyPattern specifies the policy of the columns matching a "table.column" pattern of -column-policies.
+type columnPolicyPattern struct {
+	pattern string
+	policy  ColumnPolicy
+}
+
+// columnPolicies specifies the column policies of -column-policies in order.
+var columnPolicies []columnPolicyPattern
+
+// parseColumnPolicies parses the column policies of -column-policies, e.g. "*.created_by=insertonly,users.created_at=writable".
+func parseColumnPolicies(list string) (policies []columnPolicyPattern, err error) {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		pattern, policy, ok := strings.Cut(item, "=")
+		pattern, policy = strings.TrimSpace(pattern), strings.ToLower(strings.TrimSpace(policy))
+		if !ok || !strings.Contains(pattern, ".") {
+			return nil, fmt.Errorf("invalid column policy %q, use table.column=policy", item)
+		}
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid column policy %q, %v", item, err)
+		}
+		switch ColumnPolicy(policy) {
+		case ColumnPolicyWritable, ColumnPolicyInsertOnly, ColumnPolicyReadOnly:
+		default:
+			return nil, fmt.Errorf("invalid column policy %q, use writable, insertonly or readonly", item)
+		}
+		policies = append(policies, columnPolicyPattern{pattern: pattern, policy: ColumnPolicy(policy)})
+	}
+	return
+}
+
+// getColumnPolicy returns the policy of a column and whether MySQL generates its value if an insert omits it.
+// The policy is derived from the column definition, and overridden by the policy directive of the column or table,
+// -readonly-columns and then -column-policies, whose last matching item wins:
+//   - generated columns are read-only, as MySQL rejects their values
+//   - AUTO_INCREMENT columns and columns defaulting to an expression, e.g. CURRENT_TIMESTAMP, are insert-only,
+//     unless MySQL updates them ON UPDATE CURRENT_TIMESTAMP
+func getColumnPolicy(table *TableEntity, column *ColumnEntity, directive ColumnPolicy) (policy ColumnPolicy, autoGenerated bool) {
+	extra := strings.ToUpper(column.Extra)
+	defaultValue := strings.ToUpper(column.Default.String)
+	policy = ColumnPolicyWritable
+	switch {
+	case strings.Contains(extra, "VIRTUAL GENERATED") || strings.Contains(extra, "STORED GENERATED"):
+		policy, autoGenerated = ColumnPolicyReadOnly, true
+	case strings.Contains(extra, "ON UPDATE"):
+		autoGenerated = true
+	case strings.Contains(extra, "AUTO_INCREMENT") || strings.Contains(extra, "DEFAULT_GENERATED") ||
+		strings.HasPrefix(defaultValue, "CURRENT_TIMESTAMP") || strings.HasPrefix(defaultValue, "NOW("):
+		policy, autoGenerated = ColumnPolicyInsertOnly, true
+	}
+	if directive != "" {
+		policy = directive
+	}
+	if matchColumn(readOnlyColumns, table, column.Field) {
+		policy = ColumnPolicyReadOnly
+	}
+	for _, p := range columnPolicies {
+		if matchColumn([]string{p.pattern}, table, column.Field) {
+			policy = p.policy
+		}
+	}
+	return
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestGetColumnPolicy(t *testing.T) {
+	var err error
+	readOnlyColumns, err = parseColumnPatterns("accounts.balance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	columnPolicies, err = parseColumnPolicies("*.created_*=writable, accounts.created_by=insertonly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		readOnlyColumns, columnPolicies = nil, nil
+	}()
+	table := &TableEntity{Database: "bank", Name: "accounts"}
+	tests := []struct {
+		column            ColumnEntity
+		wantPolicy        ColumnPolicy
+		wantAutoGenerated bool
+	}{
+		{ColumnEntity{Field: "id", Extra: "auto_increment"}, ColumnPolicyInsertOnly, true},
+		{ColumnEntity{Field: "email"}, ColumnPolicyWritable, false},
+		{ColumnEntity{Field: "email_upper", Extra: "VIRTUAL GENERATED"}, ColumnPolicyReadOnly, true},
+		{ColumnEntity{Field: "total", Extra: "STORED GENERATED"}, ColumnPolicyReadOnly, true},
+		{ColumnEntity{Field: "inserted_at", Extra: "DEFAULT_GENERATED"}, ColumnPolicyInsertOnly, true},
+		{ColumnEntity{Field: "inserted_on", Default: sql.NullString{String: "CURRENT_TIMESTAMP", Valid: true}}, ColumnPolicyInsertOnly, true},
+		{ColumnEntity{Field: "modified_at", Extra: "DEFAULT_GENERATED on update CURRENT_TIMESTAMP"}, ColumnPolicyWritable, true},
+		{ColumnEntity{Field: "balance"}, ColumnPolicyReadOnly, false},
+		{ColumnEntity{Field: "created_at", Extra: "DEFAULT_GENERATED"}, ColumnPolicyWritable, true},
+		{ColumnEntity{Field: "created_by"}, ColumnPolicyInsertOnly, false},
+	}
+	for _, tt := range tests {
+		policy, autoGenerated := getColumnPolicy(table, &tt.column, "")
+		if policy != tt.wantPolicy || autoGenerated != tt.wantAutoGenerated {
+			t.Errorf("getColumnPolicy(%s) = %s, %v, want %s, %v", tt.column.Field, policy, autoGenerated, tt.wantPolicy, tt.wantAutoGenerated)
+		}
+	}
+	for _, list := range []string{"accounts.id", "id=readonly", "accounts.id=hidden"} {
+		if _, err := parseColumnPolicies(list); err == nil {
+			t.Errorf("parseColumnPolicies(%q) returns no error", list)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// ForeignKeyEntity represents a column of a FOREIGN KEY constraint of information_schema.KEY_COLUMN_USAGE.
+type ForeignKeyEntity struct {
+	Name      string // constraint name
+	Column    string
+	RefSchema string // database of the referenced table
+	RefTable  string
+	RefColumn string
+}
+
+// getTableForeignKeys returns the columns of the FOREIGN KEY constraints of a table in constraint and column order,
+// of the first physical table for a sharded table.
+func getTableForeignKeys(ctx context.Context, table *TableEntity) (foreignKeys []*ForeignKeyEntity, err error) {
+	database, name := table.Database, table.Name
+	if len(table.Shards) != 0 {
+		database, name = table.Shards[0].Database, table.Shards[0].Name
+	}
+	rows, err := mysqlDB.QueryContext(ctx, "select constraint_name, column_name, referenced_table_schema, "+
+		"referenced_table_name, referenced_column_name from information_schema.key_column_usage "+
+		"where table_schema = coalesce(nullif(?, ''), database()) and table_name = ? and referenced_table_name is not null "+
+		"order by constraint_name, ordinal_position;", database, name)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to get foreign keys of table %s, %v", table.Name, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		foreignKey := &ForeignKeyEntity{}
+		err = rows.Scan(&foreignKey.Name, &foreignKey.Column, &foreignKey.RefSchema, &foreignKey.RefTable, &foreignKey.RefColumn)
+		if err != nil {
+			return nil, fmt.Errorf("error: failed to get foreign keys of table %s, %v", table.Name, err)
+		}
+		foreignKeys = append(foreignKeys, foreignKey)
+	}
+	return foreignKeys, rows.Err()
+}
+
+// RelationEntity represents a foreign key of a child table referencing a parent table, which generates
+// the navigation methods of the DAOs.
+type RelationEntity struct {
+	Child     *RenderData
+	Parent    *RenderData
+	Column    string // foreign key column of the child table
+	RefColumn string // referenced column of the parent table
+	GetName   string // method of the child DAO retrieving the parent record, e.g. GetUser
+	ListName  string // method of the parent DAO listing the child records, e.g. ListOrders
+	LoadName  string // method of the child DAO loading the parent records of child records, e.g. LoadUsersForOrders
+	KeyType   string // Go type of the referenced column, which maps the loaded parent records
+	Key       string // Go expression of the key of the child entity, e.g. int64(ordersEntity.UserID.Int64)
+	RefKey    string // Go expression of the key of the parent entity, e.g. usersEntity.ID
+	IsNull    string // Go expression reporting whether the foreign key of the child entity is NULL, empty if NOT NULL
+	Value     string // Go expression of the value of the foreign key of the parent entity, e.g. int64(usersEntity.ID)
+	CondValue string // Go expression of the condition value of value, e.g. sql.NullInt64{Int64: value, Valid: true}
+	Cond      string // condition function of the foreign key, e.g. SetOrdersUserID
+	RefCond   string // condition function of the referenced column, e.g. SetUsersID
+	// SelfReference reports whether the foreign key references the table itself
+	SelfReference bool
+}
+
+// linkRelations adds the relations of the single-column foreign keys to the render data of the child and
+// parent tables. Foreign keys are skipped if either table is not generated or sharded, either column is excluded,
+// the referenced column is nullable, or the columns are not of integer or string types.
+func linkRelations(tables []*TableEntity, rDataList []*RenderData) {
+	for i, table := range tables {
+		child := rDataList[i]
+		if child == nil || child.Shard != nil {
+			continue
+		}
+		var relations []*RelationEntity
+		for _, foreignKey := range getSingleColumnForeignKeys(table) {
+			var parent *RenderData
+			for j, other := range tables {
+				if other.Name == foreignKey.RefTable && (other.Database == "" || foreignKey.RefSchema == "" || other.Database == foreignKey.RefSchema) {
+					parent = rDataList[j]
+				}
+			}
+			if parent == nil || parent.Shard != nil {
+				continue
+			}
+			relation, err := getRelation(child, parent, foreignKey)
+			if err != nil {
+				slog.Warn(fmt.Sprintf("skip foreign key %s of table %s, %v", foreignKey.Name, child.Table, err))
+				continue
+			}
+			relations = append(relations, relation)
+		}
+		// The methods of several foreign keys to the same table, or of a foreign key to the table itself,
+		// are named by their columns
+		for _, relation := range relations {
+			count := 0
+			for _, other := range relations {
+				if other.Parent == relation.Parent {
+					count++
+				}
+			}
+			relation.SelfReference = relation.Parent == child
+			if count > 1 || relation.SelfReference {
+				suffix := "By" + strings.TrimPrefix(relation.GetName, "Get")
+				relation.ListName += suffix
+				relation.LoadName += suffix
+			}
+			child.References = append(child.References, relation)
+			relation.Parent.ReferencedBy = append(relation.Parent.ReferencedBy, relation)
+			if !relation.SelfReference {
+				addRelatedTable(child, relation.Parent)
+				addRelatedTable(relation.Parent, child)
+			}
+		}
+	}
+}
+
+// addRelatedTable adds other to the tables related to rData, unless it is already added.
+func addRelatedTable(rData, other *RenderData) {
+	for _, related := range rData.RelatedTables {
+		if related == other {
+			return
+		}
+	}
+	rData.RelatedTables = append(rData.RelatedTables, other)
+}
+
+// getSingleColumnForeignKeys returns the foreign keys of a table with a single column, the composite ones are skipped.
+func getSingleColumnForeignKeys(table *TableEntity) []*ForeignKeyEntity {
+	columns := make(map[string]int)
+	for _, foreignKey := range table.ForeignKeys {
+		columns[foreignKey.Name]++
+	}
+	var foreignKeys []*ForeignKeyEntity
+	for _, foreignKey := range table.ForeignKeys {
+		if columns[foreignKey.Name] > 1 {
+			slog.Warn(fmt.Sprintf("skip composite foreign key %s of table %s", foreignKey.Name, table.Name))
+			columns[foreignKey.Name] = 0
+			continue
+		}
+		if columns[foreignKey.Name] == 1 {
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+	}
+	return foreignKeys
+}
+
+// getRelation returns the relation of a foreign key of the child table referencing the parent table.
+func getRelation(child, parent *RenderData, foreignKey *ForeignKeyEntity) (*RelationEntity, error) {
+	childAttr, parentAttr := getAttr(child, foreignKey.Column), getAttr(parent, foreignKey.RefColumn)
+	if childAttr == nil || parentAttr == nil {
+		return nil, fmt.Errorf("column %s or %s.%s is not generated", foreignKey.Column, parent.Table, foreignKey.RefColumn)
+	}
+	if parentAttr.NullKind != NullKindNone {
+		return nil, fmt.Errorf("referenced column %s.%s is nullable", parent.Table, foreignKey.RefColumn)
+	}
+	valueType, field, ok := getNullValueField(childAttr)
+	if !ok || !isKeyType(valueType) || !isKeyType(parentAttr.Type) ||
+		(valueType == "string") != (parentAttr.Type == "string") {
+		return nil, fmt.Errorf("columns of types %s and %s cannot be related", childAttr.Type, parentAttr.Type)
+	}
+	childEntity, parentEntity := child.TableLowerCamelIdent+"Entity", parent.TableLowerCamelIdent+"Entity"
+	name := strings.TrimSuffix(foreignKey.Column, "_id")
+	if name == "" || name == "id" {
+		name = parent.TableUpperCamelIdent
+	}
+	relation := &RelationEntity{
+		Child:     child,
+		Parent:    parent,
+		Column:    foreignKey.Column,
+		RefColumn: foreignKey.RefColumn,
+		GetName:   "Get" + initialisms.ForceCamelIdentifier(name),
+		ListName:  "List" + child.TableUpperCamelIdent,
+		LoadName:  "Load" + parent.TableUpperCamelIdent + "For" + child.TableUpperCamelIdent,
+		KeyType:   parentAttr.Type,
+		Key:       convertValue(childEntity+"."+childAttr.Name+field, valueType, parentAttr.Type),
+		RefKey:    parentEntity + "." + parentAttr.Name,
+		Value:     convertValue(parentEntity+"."+parentAttr.Name, parentAttr.Type, valueType),
+		CondValue: "value",
+		Cond:      "Set" + child.TableUpperCamelIdent + childAttr.NameCamelIdent,
+		RefCond:   "Set" + parent.TableUpperCamelIdent + parentAttr.NameCamelIdent,
+	}
+	switch childAttr.NullKind {
+	case NullKindValid:
+		relation.IsNull = "!" + childEntity + "." + childAttr.Name + ".Valid"
+		relation.CondValue = childAttr.Type + "{" + strings.TrimPrefix(field, ".") + ": value, Valid: true}"
+	case NullKindPointer:
+		relation.IsNull = childEntity + "." + childAttr.Name + " == nil"
+		relation.Key = convertValue("*"+childEntity+"."+childAttr.Name, valueType, parentAttr.Type)
+		relation.CondValue = "&value"
+	}
+	return relation, nil
+}
+
+// getAttr returns the attribute of a column, or nil if the column is not generated.
+func getAttr(rData *RenderData, column string) *AttrEntity {
+	for _, attr := range rData.Attrs {
+		if attr.Tag == column {
+			return attr
+		}
+	}
+	return nil
+}
+
+// sqlNullFields maps the database/sql types of nullable columns to their value types and fields.
+var sqlNullFields = map[string][2]string{
+	"sql.NullString": {"string", "String"},
+	"sql.NullInt64":  {"int64", "Int64"},
+	"sql.NullInt32":  {"int32", "Int32"},
+	"sql.NullInt16":  {"int16", "Int16"},
+	"sql.NullByte":   {"byte", "Byte"},
+}
+
+// getNullValueField returns the Go type of the values of a column and the field selecting the value
+// from a nullable type, e.g. sql.NullInt64 -> int64, .Int64, or false if the type has no such field.
+func getNullValueField(attr *AttrEntity) (valueType, field string, ok bool) {
+	switch attr.NullKind {
+	case NullKindNone:
+		return attr.Type, "", true
+	case NullKindPointer:
+		return strings.TrimPrefix(attr.Type, "*"), "", true
+	case NullKindValid:
+		if typ, ok := strings.CutPrefix(attr.Type, "sql.Null["); ok {
+			return strings.TrimSuffix(typ, "]"), ".V", true
+		}
+		if fields, ok := sqlNullFields[attr.Type]; ok {
+			return fields[0], "." + fields[1], true
+		}
+	}
+	return "", "", false
+}
+
+// isKeyType reports whether the Go type of a column can map the records of a relation.
+func isKeyType(typ string) bool {
+	switch typ {
+	case "string", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		return true
+	}
+	return false
+}
+
+// convertValue returns the Go expression converting the value of type from to type to.
+func convertValue(value, from, to string) string {
+	if from == to {
+		return value
+	}
+	return to + "(" + value + ")"
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestLinkRelations(t *testing.T) {
+	newRData := func(table string, attrs ...*AttrEntity) *RenderData {
+		return &RenderData{
+			Table:                table,
+			TableLowerCamelIdent: initialisms.ForceLowerCamelIdentifier(table),
+			TableUpperCamelIdent: initialisms.ForceCamelIdentifier(table),
+			Attrs:                attrs,
+		}
+	}
+	newAttr := func(column, typ string, nullKind NullKind) *AttrEntity {
+		return &AttrEntity{
+			Name:           initialisms.SnakeToCamelIdentifier(column),
+			NameCamelIdent: initialisms.ForceCamelIdentifier(column),
+			Tag:            column,
+			Type:           typ,
+			NullKind:       nullKind,
+		}
+	}
+	users := newRData("users", newAttr("id", "int64", NullKindNone), newAttr("email", "string", NullKindNone))
+	orders := newRData("orders", newAttr("id", "int64", NullKindNone), newAttr("user_id", "int64", NullKindNone),
+		newAttr("created_by", "sql.NullInt64", NullKindValid), newAttr("region_id", "*int32", NullKindPointer),
+		newAttr("email", "[]byte", NullKindSlice))
+	regions := newRData("regions", newAttr("id", "int", NullKindNone))
+	logs := newRData("logs", newAttr("user_id", "int64", NullKindNone))
+	logs.Shard = &ShardData{}
+	tables := []*TableEntity{
+		{Database: "shop", Name: "users"},
+		{Database: "shop", Name: "orders", ForeignKeys: []*ForeignKeyEntity{
+			{Name: "fk_user", Column: "user_id", RefSchema: "shop", RefTable: "users", RefColumn: "id"},
+			{Name: "fk_creator", Column: "created_by", RefSchema: "shop", RefTable: "users", RefColumn: "id"},
+			{Name: "fk_region", Column: "region_id", RefSchema: "shop", RefTable: "regions", RefColumn: "id"},
+			{Name: "fk_email", Column: "email", RefSchema: "shop", RefTable: "users", RefColumn: "email"},
+			{Name: "fk_other", Column: "user_id", RefSchema: "other", RefTable: "users", RefColumn: "id"},
+			{Name: "fk_composite", Column: "user_id", RefSchema: "shop", RefTable: "accounts", RefColumn: "user_id"},
+			{Name: "fk_composite", Column: "region_id", RefSchema: "shop", RefTable: "accounts", RefColumn: "region_id"},
+		}},
+		{Database: "shop", Name: "regions"},
+		{Database: "shop", Name: "logs", ForeignKeys: []*ForeignKeyEntity{
+			{Name: "fk_user", Column: "user_id", RefSchema: "shop", RefTable: "users", RefColumn: "id"},
+		}},
+	}
+	linkRelations(tables, []*RenderData{users, orders, regions, logs})
+
+	want := []RelationEntity{
+		{GetName: "GetUser", ListName: "ListOrdersByUser", LoadName: "LoadUsersForOrdersByUser",
+			KeyType: "int64", Key: "ordersEntity.UserID", Value: "usersEntity.ID", CondValue: "value"},
+		{GetName: "GetCreatedBy", ListName: "ListOrdersByCreatedBy", LoadName: "LoadUsersForOrdersByCreatedBy",
+			KeyType: "int64", Key: "ordersEntity.CreatedBy.Int64", IsNull: "!ordersEntity.CreatedBy.Valid",
+			Value: "usersEntity.ID", CondValue: "sql.NullInt64{Int64: value, Valid: true}"},
+		{GetName: "GetRegion", ListName: "ListOrders", LoadName: "LoadRegionsForOrders",
+			KeyType: "int", Key: "int(*ordersEntity.RegionID)", IsNull: "ordersEntity.RegionID == nil",
+			Value: "int32(regionsEntity.ID)", CondValue: "&value"},
+	}
+	if len(orders.References) != len(want) {
+		t.Fatalf("orders has %d references, want %d", len(orders.References), len(want))
+	}
+	for i, got := range orders.References {
+		w := want[i]
+		if got.GetName != w.GetName || got.ListName != w.ListName || got.LoadName != w.LoadName || got.KeyType != w.KeyType ||
+			got.Key != w.Key || got.IsNull != w.IsNull || got.Value != w.Value || got.CondValue != w.CondValue {
+			t.Errorf("reference %d = %+v, want %+v", i, *got, w)
+		}
+	}
+	if len(users.ReferencedBy) != 2 || len(regions.ReferencedBy) != 1 || len(logs.References) != 0 {
+		t.Errorf("users is referenced by %d, regions by %d, logs references %d, want 2, 1 and 0",
+			len(users.ReferencedBy), len(regions.ReferencedBy), len(logs.References))
+	}
+	if len(orders.RelatedTables) != 2 || orders.RelatedTables[0] != users || orders.RelatedTables[1] != regions ||
+		len(users.RelatedTables) != 1 || users.RelatedTables[0] != orders {
+		t.Errorf("orders is related to %d tables, users to %d, want users and regions, and orders",
+			len(orders.RelatedTables), len(users.RelatedTables))
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"go/format"
+	"strings"
 	"text/template"
 
 	"github.com/kenshaw/snaker"
@@ -13,7 +14,9 @@ type Indexes map[string][]string // index name -> columns
 
 type RenderData struct {
 	Pkg                  string
+	Database             string
 	Table                string
+	Comment              string // table comment without the directives
 	TableLowerCamelIdent string
 	TableUpperCamelIdent string
 	Primary              string
@@ -21,9 +24,45 @@ type RenderData struct {
 	UniqueIndexes        Indexes
 	ShadowTables         map[string]string
 	TimeFields           TimeFields
+	Types                SharedTypes
+	Enums                []*EnumEntity
+	Constraints          []*ConstraintEntity // constraints of the columns checked by the validation
+	References           []*RelationEntity   // foreign keys of the table referencing other tables
+	ReferencedBy         []*RelationEntity   // foreign keys of other tables referencing the table
+	RelatedTables        []*RenderData       // other tables of References and ReferencedBy, linked to the fake repository
+	Shard                *ShardData          // routing of a sharded table, nil if not sharded
+	Sharding             bool                // shard routing types are generated into dao.go
+	Databases            []string            // databases of the tables, registered for the test database by dao_test.go
+	Metrics              bool                // metrics.go is generated, whose metrics are tested by dao_test.go
+	Test                 *TestData           // fixtures of the integration test, nil if -gen-tests is disabled
 	Imports              []string
 }
 
+// SharedTypes specifies the helper types used by tables, which are generated into types.go.
+type SharedTypes struct {
+	JSON     bool // JSON[T] wrapper of JSON columns
+	JSONCond bool // JSONCond conditions on JSON columns
+	Geometry bool // Geometry of spatial columns
+	Duration bool // Duration of TIME columns
+	Bits     bool // Bits of BIT(n) columns
+}
+
+// Merge returns the helper types used by either t or other.
+func (t SharedTypes) Merge(other SharedTypes) SharedTypes {
+	return SharedTypes{
+		JSON:     t.JSON || other.JSON,
+		JSONCond: t.JSONCond || other.JSONCond,
+		Geometry: t.Geometry || other.Geometry,
+		Duration: t.Duration || other.Duration,
+		Bits:     t.Bits || other.Bits,
+	}
+}
+
+// Any reports whether any helper type is used.
+func (t SharedTypes) Any() bool {
+	return t != SharedTypes{}
+}
+
 func renderTable(name string, data *RenderData) (content []byte, err error) {
 	tpl, err := tplbin.Asset("table.tpl")
 	if err != nil {
@@ -31,6 +70,7 @@ func renderTable(name string, data *RenderData) (content []byte, err error) {
 	}
 	t, err := template.New(name).Funcs(template.FuncMap{
 		"ToUpperCamel": snaker.SnakeToCamelIdentifier,
+		"lowerFirst":   lowerFirst,
 	}).Parse(string(tpl))
 	if err != nil {
 		return content, err
@@ -62,6 +102,42 @@ func renderTableConds(name string, data *RenderData) (content []byte, err error)
 	return
 }
 
+func renderTableRepository(name string, data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("repository.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New(name).Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
+func renderTableTest(name string, data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("table_test.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New(name).Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
 func renderInitDao(data *RenderData) (content []byte, err error) {
 	tpl, err := tplbin.Asset("dao.tpl")
 	if err != nil {
@@ -79,3 +155,83 @@ func renderInitDao(data *RenderData) (content []byte, err error) {
 	content, err = format.Source(buf.Bytes())
 	return
 }
+
+func renderInitDaoTest(data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("dao_test.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New("dao_test").Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
+func renderTypes(data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("types.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New("types").Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
+func renderTracing(data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("tracing.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New("tracing").Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
+func renderMetrics(data *RenderData) (content []byte, err error) {
+	tpl, err := tplbin.Asset("metrics.tpl")
+	if err != nil {
+		return content, err
+	}
+	t, err := template.New("metrics").Parse(string(tpl))
+	if err != nil {
+		return content, err
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return
+	}
+	content, err = format.Source(buf.Bytes())
+	return
+}
+
+// lowerFirst lowercases the first letter of the identifier s.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
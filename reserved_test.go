@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestReplaceReserved(t *testing.T) {
+	tests := []struct {
+		fieldName string
+		want      string
+	}{
+		{"type", "typeReserved"},
+		{"func", "funcReserved"},
+		{"range", "rangeReserved"},
+		{"select", "selectReserved"},
+		{"typeName", "typeName"},
+		{"Type", "Type"},
+		{"name", "name"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := replaceReserved(tt.fieldName); got != tt.want {
+			t.Errorf("replaceReserved(%q) = %q, want %q", tt.fieldName, got, tt.want)
+		}
+	}
+}
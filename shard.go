@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// shardTableRegexp matches the physical tables of a sharded table, e.g. orders_00.
+var shardTableRegexp = regexp.MustCompile(`^(.+)_(\d+)$`)
+
+// ShardEntity represents a physical table of a sharded table.
+type ShardEntity struct {
+	Database string
+	Name     string
+}
+
+// ShardData specifies the routing of a sharded table.
+type ShardData struct {
+	Key     string // shard key column
+	KeyName string // field name of the shard key in the conditions
+	Shards  []*ShardEntity
+}
+
+// parseShardTable returns the logical name and the suffix of a physical table name_NN.
+func parseShardTable(table string) (name string, index int, ok bool) {
+	matches := shardTableRegexp.FindStringSubmatch(table)
+	if matches == nil {
+		return "", 0, false
+	}
+	index, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return "", 0, false
+	}
+	return matches[1], index, true
+}
+
+// getShardGroups returns the physical tables name_NN of every database by logical name,
+// for the names with at least 2 physical tables.
+func getShardGroups(tables []*TableEntity) map[string][]*TableEntity {
+	groups := make(map[string][]*TableEntity)
+	for _, table := range tables {
+		if name, _, ok := parseShardTable(table.Name); ok {
+			groups[name] = append(groups[name], table)
+		}
+	}
+	for name, group := range groups {
+		if len(group) < 2 {
+			delete(groups, name)
+		}
+	}
+	return groups
+}
+
+// groupShardTables collapses the physical tables name_NN of every database into one logical table name.
+// A name is only collapsed with at least 2 physical tables, which are ordered by the numeric suffix.
+// The resolvers route a key to the position of the shard, so the suffixes must be 0 to n-1 without duplicates.
+func groupShardTables(tables []*TableEntity) ([]*TableEntity, error) {
+	groups := getShardGroups(tables)
+	result := make([]*TableEntity, 0, len(tables))
+	added := make(map[string]struct{})
+	for _, table := range tables {
+		name, _, ok := parseShardTable(table.Name)
+		if _, grouped := groups[name]; !ok || !grouped {
+			result = append(result, table)
+			continue
+		}
+		if _, ok := added[name]; ok {
+			continue
+		}
+		added[name] = struct{}{}
+		group := slices.Clone(groups[name])
+		indexes := make(map[*TableEntity]int, len(group))
+		for _, item := range group {
+			_, indexes[item], _ = parseShardTable(item.Name)
+		}
+		slices.SortStableFunc(group, func(a, b *TableEntity) int {
+			return indexes[a] - indexes[b]
+		})
+		shards := make([]*ShardEntity, 0, len(group))
+		for i, item := range group {
+			switch {
+			case i > 0 && indexes[item] == indexes[group[i-1]]:
+				return nil, fmt.Errorf("error: sharded table %s has several physical tables with suffix %d, %s.%s and %s.%s",
+					name, indexes[item], group[i-1].Database, group[i-1].Name, item.Database, item.Name)
+			case indexes[item] != i:
+				return nil, fmt.Errorf("error: sharded table %s has no physical table with suffix %d, the suffixes must be 0 to %d",
+					name, i, len(group)-1)
+			}
+			shards = append(shards, &ShardEntity{Database: item.Database, Name: item.Name})
+		}
+		result = append(result, &TableEntity{
+			Database: shards[0].Database,
+			Name:     name,
+			Ident:    name,
+			Shards:   shards,
+		})
+	}
+	return result, nil
+}
+
+// getShardData returns the routing of a sharded table, the shard key defaults to the primary key.
+func getShardData(tableEntity *TableEntity, attrs []*AttrEntity, primary string) (*ShardData, error) {
+	if len(tableEntity.Shards) == 0 {
+		return nil, nil
+	}
+	key, ok := shardKeys[tableEntity.Name]
+	if !ok {
+		key = primary
+	}
+	if key == "" {
+		return nil, fmt.Errorf("error: sharded table %s has no primary key, specify its shard key by -shard-keys", tableEntity.Name)
+	}
+	for _, attr := range attrs {
+		if attr.Tag != key {
+			continue
+		}
+		if attr.NullKind != "" {
+			return nil, fmt.Errorf("error: shard key %s.%s cannot be nullable", tableEntity.Name, key)
+		}
+		if attr.Policy == ColumnPolicyReadOnly {
+			return nil, fmt.Errorf("error: shard key %s.%s cannot be read-only", tableEntity.Name, key)
+		}
+		return &ShardData{Key: key, KeyName: attr.Name, Shards: tableEntity.Shards}, nil
+	}
+	return nil, fmt.Errorf("error: shard key %s.%s not found", tableEntity.Name, key)
+}
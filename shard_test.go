@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupShardTables(t *testing.T) {
+	tests := []struct {
+		name   string
+		tables string // database.table separated by ","
+		want   string // logical tables with their shards, or a substring of the error
+	}{
+		{
+			name:   "ordered by suffix",
+			tables: "shop.orders_01,shop.orders_00,shop.users",
+			want:   "orders[shop.orders_00 shop.orders_01] users",
+		},
+		{
+			name:   "shards in several databases",
+			tables: "shop_0.orders_00,shop_1.orders_01,shop_0.orders_02,shop_1.orders_03",
+			want:   "orders[shop_0.orders_00 shop_1.orders_01 shop_0.orders_02 shop_1.orders_03]",
+		},
+		{
+			name:   "single table is not sharded",
+			tables: "shop.logs_00,shop.users_01",
+			want:   "logs_00 users_01",
+		},
+		{
+			name:   "no suffix",
+			tables: "shop.orders,shop.order_items",
+			want:   "orders order_items",
+		},
+		{
+			name:   "gap",
+			tables: "shop.orders_00,shop.orders_01,shop.orders_03",
+			want:   "sharded table orders has no physical table with suffix 2, the suffixes must be 0 to 2",
+		},
+		{
+			name:   "not starting from 0",
+			tables: "shop.orders_01,shop.orders_02",
+			want:   "sharded table orders has no physical table with suffix 0",
+		},
+		{
+			name:   "duplicates across databases",
+			tables: "shop_0.orders_00,shop_0.orders_01,shop_1.orders_00,shop_1.orders_01",
+			want:   "sharded table orders has several physical tables with suffix 0, shop_0.orders_00 and shop_1.orders_00",
+		},
+	}
+	for _, tt := range tests {
+		var tables []*TableEntity
+		for _, item := range strings.Split(tt.tables, ",") {
+			database, name, _ := strings.Cut(item, ".")
+			tables = append(tables, &TableEntity{Database: database, Name: name, Ident: name})
+		}
+		grouped, err := groupShardTables(tables)
+		if err != nil {
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("%s: groupShardTables() error = %v, want %s", tt.name, err, tt.want)
+			}
+			continue
+		}
+		var names []string
+		for _, table := range grouped {
+			name := table.Name
+			if len(table.Shards) != 0 {
+				var shards []string
+				for _, item := range table.Shards {
+					shards = append(shards, item.Database+"."+item.Name)
+				}
+				name += "[" + strings.Join(shards, " ") + "]"
+			}
+			names = append(names, name)
+		}
+		if got := strings.Join(names, " "); got != tt.want {
+			t.Errorf("%s: groupShardTables() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
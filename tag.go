@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TagCase specifies the case of the column names in the struct tags.
+type TagCase string
+
+const (
+	// TagCaseSnake names the fields by the column names, e.g. user_id.
+	TagCaseSnake TagCase = "snake"
+	// TagCaseCamel names the fields by the column names in lower camel case, e.g. userId.
+	TagCaseCamel TagCase = "camel"
+)
+
+// tagConfig specifies a struct tag of the entity fields of -tags.
+type tagConfig struct {
+	key      string
+	nameCase TagCase
+}
+
+// structTags specifies the struct tags of -tags in order.
+var structTags []tagConfig
+
+// parseStructTags parses the struct tags of -tags, e.g. "json:camel,yaml,gorm,validate".
+func parseStructTags(list string) (tags []tagConfig, err error) {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		key, nameCase, _ := strings.Cut(item, ":")
+		key = strings.TrimSpace(key)
+		tag := tagConfig{key: key, nameCase: TagCase(strings.ToLower(strings.TrimSpace(nameCase)))}
+		switch tag.nameCase {
+		case "":
+			tag.nameCase = TagCaseSnake
+		case TagCaseSnake, TagCaseCamel:
+		default:
+			return nil, fmt.Errorf("invalid case of tag %q, use snake or camel", item)
+		}
+		if key == "" || key == "db" || strings.ContainsAny(key, " \t:\"`") {
+			return nil, fmt.Errorf("invalid tag %q", item)
+		}
+		for _, other := range tags {
+			if other.key == key {
+				return nil, fmt.Errorf("duplicate tag %q", item)
+			}
+		}
+		tags = append(tags, tag)
+	}
+	return
+}
+
+// getStructTags returns the struct tags of the entity field of a column besides db, the tags of -tags
+// in order overridden by the tag directives of the column, and then the other tag directives:
+//   - gorm tags name the column, the primary key and the AUTO_INCREMENT column
+//   - validate tags, of github.com/go-playground/validator, check the lengths of strings, the values of ENUM
+//     and @enum columns and the ranges of integers, and are left out of the other columns and the columns of @type
+//   - the other tags, e.g. json and yaml, name the field in the case of the tag, with omitempty for nullable columns
+func getStructTags(column *ColumnEntity, attr *AttrEntity, directives *Directives) []*StructTag {
+	tags := make([]*StructTag, 0, len(structTags)+len(directives.Tags))
+	overridden := make(map[string]bool, len(directives.Tags))
+	for _, config := range structTags {
+		if tag := getDirectiveTag(directives, config.key); tag != nil {
+			tags = append(tags, tag)
+			overridden[config.key] = true
+			continue
+		}
+		var value string
+		switch config.key {
+		case "gorm":
+			value = "column:" + column.Field
+			if attr.IsPk {
+				value += ";primaryKey"
+			}
+			if strings.Contains(strings.ToUpper(column.Extra), "AUTO_INCREMENT") {
+				value += ";autoIncrement"
+			}
+		case "validate":
+			if directives.Type == "" {
+				value = getValidateTag(column, attr, directives)
+			}
+		default:
+			value = column.Field
+			if config.nameCase == TagCaseCamel {
+				value = toLowerCamel(column.Field)
+			}
+			if attr.NullKind != NullKindNone {
+				value += ",omitempty"
+			}
+		}
+		if value != "" {
+			tags = append(tags, &StructTag{Key: config.key, Value: value})
+		}
+	}
+	for _, tag := range directives.Tags {
+		if !overridden[tag.Key] {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// getDirectiveTag returns the tag directive of the key, or nil if there is none.
+func getDirectiveTag(directives *Directives, key string) *StructTag {
+	for _, tag := range directives.Tags {
+		if tag.Key == key {
+			return tag
+		}
+	}
+	return nil
+}
+
+// getValidateTag returns the validator rules of the constraints of a NOT NULL string or integer column,
+// or an empty string if the column has none or its Go type cannot be validated.
+func getValidateTag(column *ColumnEntity, attr *AttrEntity, directives *Directives) string {
+	if attr.NullKind != NullKindNone {
+		return ""
+	}
+	if len(directives.Enum) != 0 {
+		values := make([]string, 0, len(directives.Enum))
+		for _, item := range directives.Enum {
+			values = append(values, strconv.FormatInt(item.Value, 10))
+		}
+		return "oneof=" + strings.Join(values, " ")
+	}
+	dataType := strings.ToLower(column.Type)
+	switch getBaseType(dataType) {
+	case "char", "varchar":
+		if _, size, _ := extractPrecisionAndScale(dataType); size > 0 {
+			// The validator counts the runes of strings, like MySQL the characters
+			return "max=" + strconv.Itoa(size)
+		}
+	case "enum":
+		values := extractEnumValues(column.Type)
+		for _, value := range values {
+			// The values of oneof are separated by spaces
+			if value == "" || strings.ContainsAny(value, " '") {
+				return ""
+			}
+		}
+		return "oneof=" + strings.Join(values, " ")
+	}
+	columnRange, ok := getIntRange(dataType)
+	typeRange, isInt := goIntRanges[attr.Type]
+	if !ok || !isInt {
+		return ""
+	}
+	// Only the bounds narrower than the Go type are checked, the others cannot be exceeded
+	var rules []string
+	if columnRange.min > typeRange.min {
+		rules = append(rules, "min="+strconv.FormatInt(columnRange.min, 10))
+	}
+	if columnRange.max < typeRange.max {
+		rules = append(rules, "max="+strconv.FormatUint(columnRange.max, 10))
+	}
+	return strings.Join(rules, ",")
+}
+
+// toLowerCamel converts a column name to lower camel case without initialisms, e.g. user_id -> userId.
+func toLowerCamel(name string) string {
+	parts := strings.Split(name, "_")
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString(strings.ToLower(part[:1]) + part[1:])
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestGetStructTags(t *testing.T) {
+	var err error
+	structTags, err = parseStructTags("json:camel, yaml, gorm, validate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		structTags = nil
+	}()
+	tests := []struct {
+		column     ColumnEntity
+		attr       AttrEntity
+		directives Directives
+		want       string
+	}{
+		{
+			ColumnEntity{Field: "id", Type: "bigint unsigned", Extra: "auto_increment"},
+			AttrEntity{Type: "int64", IsPk: true},
+			Directives{},
+			`json:"id" yaml:"id" gorm:"column:id;primaryKey;autoIncrement" validate:"min=0"`,
+		},
+		{
+			ColumnEntity{Field: "user_name", Type: "varchar(64)"},
+			AttrEntity{Type: "string"},
+			Directives{},
+			`json:"userName" yaml:"user_name" gorm:"column:user_name" validate:"max=64"`,
+		},
+		{
+			ColumnEntity{Field: "email", Type: "varchar(255)"},
+			AttrEntity{Type: "sql.NullString", NullKind: NullKindValid},
+			Directives{},
+			`json:"email,omitempty" yaml:"email,omitempty" gorm:"column:email"`,
+		},
+		{
+			ColumnEntity{Field: "role", Type: "enum('admin','member')"},
+			AttrEntity{Type: "string"},
+			Directives{},
+			`json:"role" yaml:"role" gorm:"column:role" validate:"oneof=admin member"`,
+		},
+		{
+			ColumnEntity{Field: "age", Type: "tinyint unsigned"},
+			AttrEntity{Type: "uint8"},
+			Directives{},
+			`json:"age" yaml:"age" gorm:"column:age"`,
+		},
+		{
+			ColumnEntity{Field: "status", Type: "tinyint"},
+			AttrEntity{Type: "OrdersStatus"},
+			Directives{Enum: []*EnumItem{{Name: "pending", Value: 1}, {Name: "paid", Value: 2}}},
+			`json:"status" yaml:"status" gorm:"column:status" validate:"oneof=1 2"`,
+		},
+		{
+			ColumnEntity{Field: "password_hash", Type: "char(60)"},
+			AttrEntity{Type: "string"},
+			Directives{Tags: []*StructTag{{Key: "form", Value: "-"}, {Key: "json", Value: "-"}}},
+			`json:"-" yaml:"password_hash" gorm:"column:password_hash" validate:"max=60" form:"-"`,
+		},
+	}
+	for _, tt := range tests {
+		var got string
+		for _, tag := range getStructTags(&tt.column, &tt.attr, &tt.directives) {
+			if got != "" {
+				got += " "
+			}
+			got += tag.Key + `:"` + tag.Value + `"`
+		}
+		if got != tt.want {
+			t.Errorf("getStructTags(%s) = %s, want %s", tt.column.Field, got, tt.want)
+		}
+	}
+	for _, list := range []string{"db", "json:kebab", "json,json", "js on"} {
+		if _, err := parseStructTags(list); err == nil {
+			t.Errorf("parseStructTags(%q) returns no error", list)
+		}
+	}
+}
+
+func TestToLowerCamel(t *testing.T) {
+	tests := map[string]string{
+		"id":            "id",
+		"user_id":       "userId",
+		"api_key_value": "apiKeyValue",
+		"UserName":      "userName",
+		"_row__count":   "rowCount",
+	}
+	for name, want := range tests {
+		if got := toLowerCamel(name); got != want {
+			t.Errorf("toLowerCamel(%s) = %s, want %s", name, got, want)
+		}
+	}
+}
@@ -14,6 +14,9 @@ import (
 type {{ .TableUpperCamelIdent }}Conds struct {
 {{- range .Attrs }}
         {{ .Name }} *{{ .Type }} {{ if .Comment }}// {{ .Comment }} {{- end }} 
+        {{- if .IsJSON }}
+        {{ .Name }}JSONConds []JSONCond
+        {{- end }}
 {{- end }}
 }
 
@@ -36,6 +39,24 @@ func Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}({{ .NameCamel }} {{ .T
 		o.{{ .Name }} = &{{ .NameCamel }}
 	}
 }
+{{- if .IsJSON }}
+
+// Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}Contains returns a closure function for the condition
+// JSON_CONTAINS({{ .Tag }}, doc, path), doc is a JSON document and path defaults to "$".
+func Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}Contains(path, doc string) {{ $.TableUpperCamelIdent }}Cond {
+	return func(o *{{ $.TableUpperCamelIdent }}Conds) {
+		o.{{ .Name }}JSONConds = append(o.{{ .Name }}JSONConds, JSONCond{Path: path, Contains: true, Value: doc})
+	}
+}
+
+// Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}Extract returns a closure function for the condition
+// {{ .Tag }}->>path = value, e.g. Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}Extract("$.name", "foo").
+func Set{{ $.TableUpperCamelIdent }}{{ .NameCamelIdent }}Extract(path string, value any) {{ $.TableUpperCamelIdent }}Cond {
+	return func(o *{{ $.TableUpperCamelIdent }}Conds) {
+		o.{{ .Name }}JSONConds = append(o.{{ .Name }}JSONConds, JSONCond{Path: path, Value: value})
+	}
+}
+{{- end }}
 
 {{ end }}
 
@@ -46,9 +67,41 @@ func Build{{ .TableUpperCamelIdent }}Conds(sqlCond *sqlbuilder.Cond, conds *{{ .
     {{- range .Attrs}}
         {{- if ne .Type "time.Time" }} 
             if conds.{{ .Name }} != nil {
-                args = append(args, sqlCond.Equal("{{ .Tag }}", *conds.{{ .Name }}))
+            {{- if .NullKind }}
+                if {{ if eq .NullKind "valid" }}!conds.{{ .Name }}.Valid{{ else }}*conds.{{ .Name }} == nil{{ end }} {
+                    args = append(args, sqlCond.IsNull("{{ .Tag }}"))
+                } else {
+                    args = append(args, {{ template "equal" . }})
+                }
+            {{- else }}
+                args = append(args, {{ template "equal" . }})
+            {{- end }}
+            }
+        {{- end }}
+        {{- if .IsJSON }}
+            for _, jc := range conds.{{ .Name }}JSONConds {
+                path := jc.Path
+                if path == "" {
+                    path = "$"
+                }
+                if jc.Contains {
+                    args = append(args, "JSON_CONTAINS({{ .Tag }}, " + sqlCond.Var(jc.Value) + ", " + sqlCond.Var(path) + ")")
+                } else {
+                    // Equivalent to {{ .Tag }}->>path, which does not accept a placeholder as path.
+                    args = append(args, "JSON_UNQUOTE(JSON_EXTRACT({{ .Tag }}, " + sqlCond.Var(path) + ")) = " + sqlCond.Var(jc.Value))
+                }
             }
         {{- end }}
     {{- end }}
 	return args
 }
+
+{{- define "value" }}{{ if eq .NullKind "pointer" }}**conds.{{ .Name }}{{ else }}*conds.{{ .Name }}{{ end }}{{ end }}
+
+{{- define "equal" }}
+    {{- if .Cast -}}
+        "{{ .Tag }} = CAST(" + sqlCond.Var({{ template "value" . }}) + " AS {{ .Cast }})"
+    {{- else -}}
+        sqlCond.Equal("{{ .Tag }}", {{ template "value" . }})
+    {{- end }}
+{{- end }}
@@ -3,11 +3,27 @@
 package {{ .Pkg }}
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+    {{- if .Sharding }}
+	"hash/fnv"
+    {{- end }}
+	"log/slog"
+	"math/rand/v2"
 	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unicode/utf8"
     "database/sql"
+    "database/sql/driver"
 
 	"github.com/go-sql-driver/mysql"
 )
@@ -17,34 +33,1199 @@ const (
 	ShadowCtxKey string = "X-Shadow"
     // ForceMasterIdentity specifies the force master tag in SQL comment.
     ForceMasterIdentity string = "/*force_master*/ "
+    // DefaultHealthCheckInterval specifies the default interval of replica health checks.
+    DefaultHealthCheckInterval = 5 * time.Second
 )
 
 var (
 	globalDB     *sql.DB
+	globalCluster *cluster
+
+	clustersMu sync.RWMutex
+	clusters   = make(map[string]*cluster) // database name -> connections
+)
+
+// ReplicaPolicy specifies how read operations are balanced among replicas.
+type ReplicaPolicy int
+
+const (
+    // RoundRobin sends reads to the healthy replicas in turn.
+    RoundRobin ReplicaPolicy = iota
+    // LeastConnections sends reads to the healthy replica with the fewest connections in use.
+    LeastConnections
 )
 
+// Option configures the connections created by Init and Register.
+type Option func(*options)
+
+type options struct {
+    replicas            []*mysql.Config
+    policy              ReplicaPolicy
+    healthCheckInterval time.Duration
+    setups              []func(name string, c *cluster) error // run after the connections are opened
+}
+
+// WithReplicas adds read replicas. Get, List, All, Count and QueryReplica are sent to a healthy
+// replica, while writes and ForceMaster DAOs use the primary.
+func WithReplicas(cfgs ...*mysql.Config) Option {
+    return func(o *options) {
+        o.replicas = append(o.replicas, cfgs...)
+    }
+}
+
+// WithReplicaPolicy sets how reads are balanced among replicas, RoundRobin by default.
+func WithReplicaPolicy(policy ReplicaPolicy) Option {
+    return func(o *options) {
+        o.policy = policy
+    }
+}
+
+// WithHealthCheckInterval sets the interval of replica health checks.
+func WithHealthCheckInterval(interval time.Duration) Option {
+    return func(o *options) {
+        o.healthCheckInterval = interval
+    }
+}
+
+// WithLogger logs failed DAO operations at the error level and operations slower than
+// slowThreshold at the warn level with logger, a threshold <= 0 disables slow query logging.
+// The logger applies to the operations of the DAOs of the database of the connection.
+func WithLogger(logger *slog.Logger, slowThreshold time.Duration) Option {
+    return func(o *options) {
+        o.setups = append(o.setups, func(name string, c *cluster) error {
+            if logger == nil {
+                return errors.New("logger is nil")
+            }
+            c.logger = &logHook{logger: logger, slowThreshold: slowThreshold}
+            addLogHook.Do(func() {
+                AddHook(HookFuncs{After: logQuery})
+            })
+            return nil
+        })
+    }
+}
+
 // Init initializes the database connection and adds shadow mapping.
-func Init(ctx context.Context, cfg *mysql.Config) (err error) {
+// The connection is the default one, and is also registered under the database name of cfg.
+func Init(ctx context.Context, cfg *mysql.Config, opts ...Option) (err error) {
     if cfg == nil {
         err = errors.New("mysql config is nil")
         return
     }
-    connector, err := mysql.NewConnector(cfg)
+    c, err := openCluster(cfg.DBName, cfg, opts)
     if err != nil {
         return
     }
-	globalDB = sql.OpenDB(connector)
+    clustersMu.Lock()
+    clusters[cfg.DBName] = c
+	globalDB = c.primary
+	globalCluster = c
+    clustersMu.Unlock()
 	return
 }
 
-// Close closes the connection pool. Use this in the main function via defer.
+// Register registers the connection of the database name, which is used by the DAOs
+// of the tables generated from that database. The operations of DAOs of databases without
+// a registered connection return an error, see getCluster.
+func Register(ctx context.Context, name string, cfg *mysql.Config, opts ...Option) (err error) {
+    if cfg == nil {
+        err = errors.New("mysql config is nil")
+        return
+    }
+    c, err := openCluster(name, cfg, opts)
+    if err != nil {
+        return
+    }
+    clustersMu.Lock()
+    clusters[name] = c
+    clustersMu.Unlock()
+    return
+}
+
+// getCluster returns the connection registered for the database name, or the default one for an empty name.
+// It does not fall back to the default connection for other names, whose server or schema may not have the tables.
+func getCluster(name string) (*cluster, error) {
+    clustersMu.RLock()
+    defer clustersMu.RUnlock()
+    if c, ok := clusters[name]; ok {
+        return c, nil
+    }
+    if name == "" {
+        if globalCluster == nil {
+            return nil, errors.New("database connection is not initialized")
+        }
+        return globalCluster, nil
+    }
+    return nil, fmt.Errorf("no connection is registered for database %s, see Init and Register", name)
+}
+
+// Close closes the connection pools. Use this in the main function via defer.
 func Close() {
-    if globalDB != nil {
-        globalDB.Close()
+    clustersMu.Lock()
+    defer clustersMu.Unlock()
+    closed := make(map[*cluster]struct{}, len(clusters))
+    for name, c := range clusters {
+        if _, ok := closed[c]; !ok {
+            c.close()
+            closed[c] = struct{}{}
+        }
+        delete(clusters, name)
+    }
+    if _, ok := closed[globalCluster]; globalCluster != nil && !ok {
+        globalCluster.close()
+    }
+    globalCluster = nil
+    globalDB = nil
+}
+
+type primaryCtxKey struct{}
+
+type sessionCtxKey struct{}
+
+// WithPrimary returns a context whose read operations are sent to the primary.
+func WithPrimary(ctx context.Context) context.Context {
+    return context.WithValue(ctx, primaryCtxKey{}, true)
+}
+
+// WithSession returns a context which pins read operations to the primary
+// once a write operation has been executed with it, so that the reads see the writes.
+func WithSession(ctx context.Context) context.Context {
+    return context.WithValue(ctx, sessionCtxKey{}, new(atomic.Bool))
+}
+
+// usePrimary reports whether read operations with ctx must be sent to the primary.
+func usePrimary(ctx context.Context) bool {
+    if v, ok := ctx.Value(primaryCtxKey{}).(bool); ok && v {
+        return true
+    }
+    if _, ok := ctx.Value(txCtxKey{}).(*txState); ok {
+        return true
+    }
+    written, ok := ctx.Value(sessionCtxKey{}).(*atomic.Bool)
+    return ok && written.Load()
+}
+
+// markWritten records a write operation in the session of ctx, if any.
+func markWritten(ctx context.Context) {
+    if written, ok := ctx.Value(sessionCtxKey{}).(*atomic.Bool); ok {
+        written.Store(true)
+    }
+}
+
+var (
+    // ErrNotFound is returned by First if no record meets the criteria.
+    ErrNotFound = errors.New("record not found")
+    // ErrDuplicateKey matches the errors of duplicate values of unique indexes, MySQL errors 1062 and 1586.
+    ErrDuplicateKey = errors.New("duplicate key")
+    // ErrForeignKeyViolation matches the errors of foreign key constraints, MySQL errors 1216, 1217, 1451 and 1452.
+    ErrForeignKeyViolation = errors.New("foreign key violation")
+    // ErrDeadlock matches the deadlock errors, MySQL error 1213.
+    ErrDeadlock = errors.New("deadlock")
+    // ErrLockWaitTimeout matches the lock wait timeout errors, MySQL error 1205.
+    ErrLockWaitTimeout = errors.New("lock wait timeout")
+)
+
+// Error is an error of a DAO operation. errors.Is matches its Kind, and errors.Is and errors.As
+// match the driver error, e.g. errors.Is(err, ErrDuplicateKey) or errors.As(err, &mysqlErr).
+type Error struct {
+    Table     string
+    Operation string
+    Kind      error    // ErrNotFound, ErrDuplicateKey, ErrForeignKeyViolation, ErrDeadlock or ErrLockWaitTimeout
+    Index     string   // unique index violated by ErrDuplicateKey, empty if unknown
+    Columns   []string // columns of Index
+    Err       error    // driver error, nil for ErrNotFound
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+    msg := e.Operation + ": " + e.Kind.Error()
+    if e.Table != "" {
+        msg = e.Table + " " + msg
+    }
+    if e.Index != "" {
+        msg += " on index " + e.Index
+        if len(e.Columns) != 0 {
+            msg += " (" + strings.Join(e.Columns, ", ") + ")"
+        }
+    }
+    if e.Err != nil {
+        msg += ": " + e.Err.Error()
+    }
+    return msg
+}
+
+// Unwrap returns the kind and the driver error.
+func (e *Error) Unwrap() []error {
+    if e.Err == nil {
+        return []error{e.Kind}
+    }
+    return []error{e.Kind, e.Err}
+}
+
+// mapError wraps the MySQL errors of the sentinel errors into *Error, and returns other errors as they are.
+func mapError(table, operation string, uniqueIndexes map[string][]string, err error) error {
+    var mysqlErr *mysql.MySQLError
+    if err == nil || !errors.As(err, &mysqlErr) {
+        return err
+    }
+    e := &Error{Table: table, Operation: operation, Err: err}
+    switch mysqlErr.Number {
+    case 1062, 1586:
+        e.Kind = ErrDuplicateKey
+        e.Index, e.Columns = duplicateKeyIndex(mysqlErr.Message, uniqueIndexes)
+    case 1216, 1217, 1451, 1452:
+        e.Kind = ErrForeignKeyViolation
+    case 1213:
+        e.Kind = ErrDeadlock
+    case 1205:
+        e.Kind = ErrLockWaitTimeout
+    default:
+        return err
+    }
+    return e
+}
+
+// duplicateKeyIndex returns the unique index of a duplicate entry message and its columns,
+// e.g. "Duplicate entry 'foo' for key 'users.email'" of MySQL 8, or "... for key 'email'".
+func duplicateKeyIndex(message string, uniqueIndexes map[string][]string) (string, []string) {
+    i := strings.LastIndex(message, " for key '")
+    if i < 0 {
+        return "", nil
+    }
+    index := strings.TrimSuffix(message[i+len(" for key '"):], "'")
+    if columns, ok := uniqueIndexes[index]; ok {
+        return index, columns
+    }
+    // MySQL 8 qualifies the index by the physical table, which differs for sharded tables
+    if j := strings.LastIndexByte(index, '.'); j >= 0 {
+        index = index[j+1:]
+    }
+    return index, uniqueIndexes[index]
+}
+
+// ColumnPolicy specifies which write operations of the DAOs may set a column.
+type ColumnPolicy int
+
+const (
+    // ColumnWritable columns are written by Insert, InsertMany and Update.
+    ColumnWritable ColumnPolicy = iota
+    // ColumnInsertOnly columns are written by Insert and InsertMany but not Update, e.g. AUTO_INCREMENT columns.
+    ColumnInsertOnly
+    // ColumnReadOnly columns are never written, e.g. generated columns.
+    ColumnReadOnly
+)
+
+// String returns the name of the policy.
+func (p ColumnPolicy) String() string {
+    switch p {
+    case ColumnInsertOnly:
+        return "insert-only"
+    case ColumnReadOnly:
+        return "read-only"
+    }
+    return "writable"
+}
+
+// ErrColumnNotWritable is returned when Insert, InsertMany or Update writes a column its ColumnPolicy forbids.
+var ErrColumnNotWritable = errors.New("column is not writable")
+
+// checkColumnPolicies returns ErrColumnNotWritable if the values write a column the policies forbid
+// for the operation, the columns missing from policies are writable.
+func checkColumnPolicies(table string, policies map[string]ColumnPolicy, values map[string]any, update bool) error {
+    for field := range values {
+        if policy := policies[field]; policy == ColumnReadOnly || (update && policy == ColumnInsertOnly) {
+            return fmt.Errorf("%w: column %s.%s is %s", ErrColumnNotWritable, table, field, policy)
+        }
+    }
+    return nil
+}
+
+// ErrInvalidValues matches the *ValidationError of values violating the constraints of the columns.
+var ErrInvalidValues = errors.New("invalid values")
+
+// FieldError describes a value violating a constraint of its column.
+type FieldError struct {
+    Column string
+    Rule   string // required, max_length, min, max or enum
+    Detail string // e.g. "exceeds 64 characters"
+}
+
+// ValidationError lists every value of a record violating the constraints of the columns of a table,
+// which are derived from the column definitions. Validate of the entities returns it, and Insert, InsertMany
+// and Update return it before writing. errors.Is matches ErrInvalidValues.
+type ValidationError struct {
+    Table  string
+    Fields []FieldError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+    details := make([]string, 0, len(e.Fields))
+    for _, field := range e.Fields {
+        details = append(details, field.Column+" "+field.Detail)
+    }
+    return e.Table + " " + ErrInvalidValues.Error() + ": " + strings.Join(details, ", ")
+}
+
+// Is reports whether target is ErrInvalidValues.
+func (e *ValidationError) Is(target error) bool {
+    return target == ErrInvalidValues
+}
+
+// columnConstraint specifies the constraints of a column derived from its definition.
+type columnConstraint struct {
+    column    string
+    notNull   bool     // the values cannot be NULL
+    required  bool     // inserts must set the column
+    maxLength int      // maximum characters of CHAR and VARCHAR columns
+    ranged    bool     // the integer values are between min and max
+    min       int64
+    max       uint64
+    values    []string // values of ENUM columns, members of SET columns or integer values of @enum
+    isSet     bool
+}
+
+// validateValues returns a *ValidationError if the values of a record violate the constraints,
+// and inserts must also set the required columns.
+func validateValues(table string, constraints []columnConstraint, values map[string]any, insert bool) error {
+    var fields []FieldError
+    for _, c := range constraints {
+        value, ok := values[c.column]
+        if !ok {
+            if insert && c.required {
+                fields = append(fields, FieldError{Column: c.column, Rule: "required", Detail: "is required"})
+            }
+            continue
+        }
+        if field := c.check(value); field != nil {
+            fields = append(fields, *field)
+        }
+    }
+    if len(fields) == 0 {
+        return nil
+    }
+    return &ValidationError{Table: table, Fields: fields}
+}
+
+// check returns the violation of the constraint by a value, or nil. Pointers and driver.Valuer values,
+// e.g. sql.NullString, are checked by their values, and the values of other types than the column's,
+// e.g. sqlbuilder expressions, are left to MySQL.
+func (c columnConstraint) check(value any) *FieldError {
+    rv := reflect.ValueOf(value)
+    for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+        rv = rv.Elem()
+    }
+    if rv.IsValid() && rv.Kind() != reflect.Pointer && rv.CanInterface() {
+        value = rv.Interface()
+        // The generated ENUM, SET and @enum types know their values
+        if enum, ok := value.(interface{ IsValid() bool }); ok && len(c.values) != 0 {
+            if !enum.IsValid() {
+                return &FieldError{Column: c.column, Rule: "enum", Detail: "is not a value of the column"}
+            }
+            return nil
+        }
+        if valuer, ok := value.(driver.Valuer); ok {
+            v, err := valuer.Value()
+            if err != nil {
+                return nil
+            }
+            rv = reflect.ValueOf(v)
+        }
+    }
+    switch rv.Kind() {
+    case reflect.Invalid, reflect.Pointer, reflect.Slice, reflect.Map:
+        if (!rv.IsValid() || rv.IsNil()) && c.notNull {
+            return &FieldError{Column: c.column, Rule: "required", Detail: "cannot be NULL"}
+        }
+    case reflect.String:
+        s := rv.String()
+        if c.maxLength > 0 && utf8.RuneCountInString(s) > c.maxLength {
+            return &FieldError{Column: c.column, Rule: "max_length", Detail: fmt.Sprintf("exceeds %d characters", c.maxLength)}
+        }
+        members := []string{s}
+        if c.isSet {
+            members = strings.Split(s, ",")
+            if s == "" {
+                members = nil
+            }
+        }
+        for _, member := range members {
+            if len(c.values) != 0 && !slices.Contains(c.values, member) {
+                return &FieldError{Column: c.column, Rule: "enum", Detail: "is not a value of the column"}
+            }
+        }
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        n := rv.Int()
+        if c.ranged && n < c.min {
+            return &FieldError{Column: c.column, Rule: "min", Detail: fmt.Sprintf("is less than %d", c.min)}
+        }
+        if c.ranged && n > 0 && uint64(n) > c.max {
+            return &FieldError{Column: c.column, Rule: "max", Detail: fmt.Sprintf("is greater than %d", c.max)}
+        }
+        if len(c.values) != 0 && !slices.Contains(c.values, strconv.FormatInt(n, 10)) {
+            return &FieldError{Column: c.column, Rule: "enum", Detail: "is not a value of the column"}
+        }
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        n := rv.Uint()
+        if c.ranged && n > c.max {
+            return &FieldError{Column: c.column, Rule: "max", Detail: fmt.Sprintf("is greater than %d", c.max)}
+        }
+        if len(c.values) != 0 && !slices.Contains(c.values, strconv.FormatUint(n, 10)) {
+            return &FieldError{Column: c.column, Rule: "enum", Detail: "is not a value of the column"}
+        }
+    }
+    return nil
+}
+
+// DefaultRetryPolicy specifies the retry policy of new DAOs, which disables retries by default.
+var DefaultRetryPolicy RetryPolicy
+
+// RetryPolicy specifies the retries of write operations failing with ErrDeadlock, ErrLockWaitTimeout
+// or a connection reset, with jittered exponential backoff. Operations in a transaction of Transaction
+// are not retried, as the transaction is retried as a whole.
+type RetryPolicy struct {
+    MaxAttempts int           // attempts including the first one, retries are disabled if less than 2
+    BaseDelay   time.Duration // delay before the first retry, doubled for every next retry
+    MaxDelay    time.Duration // maximum delay, unlimited if 0
+    // IdempotentInserts allows retrying inserts after connection resets. A reset insert may have been
+    // applied, so enable it only if a repeated insert is harmless, e.g. rejected by a unique key.
+    IdempotentInserts bool
+    // IdempotentUpdates allows retrying updates after connection resets. A reset update may have been
+    // applied, so enable it only if the updates set absolute values, not e.g. sqlbuilder.Raw("n = n + 1").
+    // Deletes are retried after connection resets, and custom Exec statements never are.
+    IdempotentUpdates bool
+}
+
+// do runs fn until it succeeds, fails with an error which is not retryable, or the attempts are used up.
+func (p RetryPolicy) do(ctx context.Context, retryable func(err error) bool, fn func() error) error {
+    for attempt := 1; ; attempt++ {
+        err := fn()
+        if err == nil || attempt >= p.MaxAttempts || !retryable(err) {
+            return err
+        }
+        timer := time.NewTimer(p.delay(attempt))
+        select {
+        case <-ctx.Done():
+            timer.Stop()
+            return err
+        case <-timer.C:
+        }
+    }
+}
+
+// delay returns the jittered delay before the retry after the attempt, in [d/2, d] of the exponential delay d.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+    d := p.BaseDelay
+    for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+        d *= 2
+    }
+    if p.MaxDelay > 0 && d > p.MaxDelay {
+        d = p.MaxDelay
+    }
+    if d <= 0 {
+        return 0
+    }
+    return d/2 + rand.N(d/2+1)
+}
+
+// isRetryable reports whether an operation failing with err can be retried. Deadlocks and lock wait
+// timeouts roll the statement back, and driver.ErrBadConn is returned before sending it, while other
+// connection resets may happen after it was applied, so they are only retried for idempotent operations.
+func isRetryable(err error, idempotent bool) bool {
+    switch {
+    case errors.Is(err, ErrDeadlock), errors.Is(err, ErrLockWaitTimeout), errors.Is(err, driver.ErrBadConn):
+        return true
+    case errors.Is(err, mysql.ErrInvalidConn), errors.Is(err, syscall.ECONNRESET):
+        return idempotent
+    }
+    return false
+}
+
+// executor executes statements on a connection pool or in a transaction.
+type executor interface {
+    ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+    QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+type txCtxKey struct{}
+
+// txState is the transaction of Transaction in the context.
+type txState struct {
+    db *sql.DB
+    tx *sql.Tx
+}
+
+// execer returns the transaction of ctx if it was begun on db, otherwise db.
+func execer(ctx context.Context, db *sql.DB) executor {
+    if state, ok := ctx.Value(txCtxKey{}).(*txState); ok && state.db == db {
+        return state.tx
+    }
+    return db
+}
+
+// TxOption configures Transaction.
+type TxOption func(*txOptions)
+
+type txOptions struct {
+    database string
+    policy   RetryPolicy
+    sqlOpts  *sql.TxOptions
+}
+
+// TxDatabase begins the transaction on the connection registered for the database name, the default connection by default.
+func TxDatabase(name string) TxOption {
+    return func(o *txOptions) {
+        o.database = name
+    }
+}
+
+// TxRetry retries the transaction by the policy, fn must be safe to run again.
+func TxRetry(policy RetryPolicy) TxOption {
+    return func(o *txOptions) {
+        o.policy = policy
+    }
+}
+
+// TxIsolation sets the isolation level of the transaction.
+func TxIsolation(level sql.IsolationLevel) TxOption {
+    return func(o *txOptions) {
+        o.sqlOpts = &sql.TxOptions{Isolation: level}
+    }
+}
+
+// Transaction runs fn in a transaction on the primary, which is committed if fn returns nil and
+// rolled back otherwise. DAO operations with the context passed to fn are executed in the transaction,
+// if they use its connection. A Transaction with a context of a transaction on the same connection
+// runs fn in that transaction. With TxRetry, the transaction is retried on deadlocks, lock wait
+// timeouts and connection resets, except for connection resets on commit.
+func Transaction(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error {
+    var o txOptions
+    for _, opt := range opts {
+        opt(&o)
+    }
+    c, err := getCluster(o.database)
+    if err != nil {
+        return err
+    }
+    if state, ok := ctx.Value(txCtxKey{}).(*txState); ok && state.db == c.primary {
+        return fn(ctx)
+    }
+    var committing bool
+    return o.policy.do(ctx, func(err error) bool {
+        return isRetryable(err, !committing)
+    }, func() error {
+        committing = false
+        tx, err := c.primary.BeginTx(ctx, o.sqlOpts)
+        if err != nil {
+            return mapError("", "Begin", nil, err)
+        }
+        if err = fn(context.WithValue(ctx, txCtxKey{}, &txState{db: c.primary, tx: tx})); err != nil {
+            tx.Rollback()
+            return err
+        }
+        committing = true
+        return mapError("", "Commit", nil, tx.Commit())
+    })
+}
+
+// QueryInfo describes an operation of a DAO for hooks.
+type QueryInfo struct {
+    Database  string
+    Table     string
+    Operation string // Insert, InsertMany, Get, Count, List, All, Update, Delete, Query, QueryReplica or Exec
+    SQL       string
+    Args      []any
+    Start     time.Time
+    // Duration, Rows and Err are set before AfterQuery.
+    Duration time.Duration
+    Rows     int64 // records returned by reads or affected by writes, 0 on error
+    Err      error
+}
+
+// Hook observes the operations of DAOs, e.g. for logging, metrics and auditing.
+// The context returned by BeforeQuery is used by the operation and passed to AfterQuery.
+type Hook interface {
+    BeforeQuery(ctx context.Context, info *QueryInfo) context.Context
+    AfterQuery(ctx context.Context, info *QueryInfo)
+}
+
+// HookFuncs adapts functions to a Hook, nil functions are skipped.
+type HookFuncs struct {
+    Before func(ctx context.Context, info *QueryInfo) context.Context
+    After  func(ctx context.Context, info *QueryInfo)
+}
+
+// BeforeQuery implements Hook.
+func (h HookFuncs) BeforeQuery(ctx context.Context, info *QueryInfo) context.Context {
+    if h.Before == nil {
+        return ctx
+    }
+    return h.Before(ctx, info)
+}
+
+// AfterQuery implements Hook.
+func (h HookFuncs) AfterQuery(ctx context.Context, info *QueryInfo) {
+    if h.After != nil {
+        h.After(ctx, info)
+    }
+}
+
+var (
+    hooksMu sync.RWMutex
+    hooks   []Hook
+)
+
+// AddHook adds hooks invoked by the operations of all DAOs.
+func AddHook(h ...Hook) {
+    hooksMu.Lock()
+    defer hooksMu.Unlock()
+    hooks = append(hooks, h...)
+}
+
+// startQuery invokes BeforeQuery of the global hooks and then the DAO hooks, and returns
+// the context of the operation and the function invoking AfterQuery in reverse order.
+func startQuery(ctx context.Context, daoHooks []Hook, database, table, operation, query string, args []any) (context.Context, func(rows int64, err error)) {
+    hooksMu.RLock()
+    all := make([]Hook, 0, len(hooks)+len(daoHooks))
+    all = append(all, hooks...)
+    hooksMu.RUnlock()
+    all = append(all, daoHooks...)
+    if len(all) == 0 {
+        return ctx, func(int64, error) {}
+    }
+    info := &QueryInfo{
+        Database:  database,
+        Table:     table,
+        Operation: operation,
+        SQL:       query,
+        Args:      args,
+        Start:     time.Now(),
+    }
+    for _, hook := range all {
+        ctx = hook.BeforeQuery(ctx, info)
+    }
+    return ctx, func(rows int64, err error) {
+        info.Duration = time.Since(info.Start)
+        if err != nil {
+            rows = 0
+        }
+        info.Rows = rows
+        info.Err = err
+        for i := len(all) - 1; i >= 0; i-- {
+            all[i].AfterQuery(ctx, info)
+        }
+    }
+}
+
+var addLogHook sync.Once
+
+// logQuery logs the operation with the logger of the connection of its database, if WithLogger set one.
+func logQuery(ctx context.Context, info *QueryInfo) {
+    if c, err := getCluster(info.Database); err == nil && c.logger != nil {
+        c.logger.log(ctx, info)
+    }
+}
+
+// logHook logs failed and slow DAO operations.
+type logHook struct {
+    logger        *slog.Logger
+    slowThreshold time.Duration
+}
+
+func (h *logHook) log(ctx context.Context, info *QueryInfo) {
+    var level slog.Level
+    var msg string
+    switch {
+    case info.Err != nil:
+        level, msg = slog.LevelError, "dao operation failed"
+    case h.slowThreshold > 0 && info.Duration >= h.slowThreshold:
+        level, msg = slog.LevelWarn, "dao slow query"
+    default:
+        return
+    }
+    if !h.logger.Enabled(ctx, level) {
+        return
+    }
+    attrs := []slog.Attr{
+        slog.String("db", info.Database),
+        slog.String("table", info.Table),
+        slog.String("operation", info.Operation),
+        slog.Duration("duration", info.Duration),
+        slog.Int64("rows", info.Rows),
+        slog.String("sql", redactSQL(info.SQL, info.Args)),
+        slog.String("caller", callerLocation()),
+    }
+    if info.Err != nil {
+        attrs = append(attrs, slog.Any("error", info.Err))
+    }
+    h.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// redactSQL interpolates args into the ? placeholders of query outside of quotes.
+// Numbers, booleans, times and NULL are kept, other values are replaced by '?' to hide their content.
+func redactSQL(query string, args []any) string {
+    var b strings.Builder
+    var quote byte
+    next := 0
+    for i := 0; i < len(query); i++ {
+        ch := query[i]
+        switch {
+        case quote != 0:
+            if ch == '\\' && i+1 < len(query) {
+                b.WriteByte(ch)
+                i++
+                ch = query[i]
+            } else if ch == quote {
+                quote = 0
+            }
+        case ch == '\'' || ch == '"' || ch == '`':
+            quote = ch
+        case ch == '?' && next < len(args):
+            b.WriteString(redactValue(args[next]))
+            next++
+            continue
+        }
+        b.WriteByte(ch)
+    }
+    return b.String()
+}
+
+func redactValue(arg any) string {
+    if valuer, ok := arg.(driver.Valuer); ok {
+        if v, err := valuer.Value(); err == nil {
+            arg = v
+        }
+    }
+    switch v := arg.(type) {
+    case nil:
+        return "NULL"
+    case bool:
+        return strconv.FormatBool(v)
+    case time.Time:
+        return "'" + v.Format("2006-01-02 15:04:05.999999") + "'"
+    case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+        return fmt.Sprint(v)
+    }
+    return "'?'"
+}
+
+// callerLocation returns the file:line of the first caller outside of this package.
+func callerLocation() string {
+    pcs := make([]uintptr, 32)
+    n := runtime.Callers(2, pcs)
+    frames := runtime.CallersFrames(pcs[:n])
+    pkg := packagePath()
+    for {
+        frame, more := frames.Next()
+        if !strings.HasPrefix(frame.Function, pkg+".") && !strings.HasPrefix(frame.Function, "runtime.") {
+            return frame.File + ":" + strconv.Itoa(frame.Line)
+        }
+        if !more {
+            return ""
+        }
+    }
+}
+
+// packagePath returns the import path of this package.
+func packagePath() string {
+    type marker struct{}
+    return reflect.TypeOf(marker{}).PkgPath()
+}
+
+type replica struct {
+    db      *sql.DB
+    healthy atomic.Bool
+}
+
+// cluster is a primary connection pool with optional read replicas.
+type cluster struct {
+    primary  *sql.DB
+    replicas []*replica
+    policy   ReplicaPolicy
+    next     atomic.Uint64
+    stop     chan struct{}
+    wg       sync.WaitGroup
+    logger   *logHook // set by WithLogger, nil if it is not used
+    closers  []func() // run by close, e.g. to unregister the metrics of the connections
+}
+
+func openCluster(name string, cfg *mysql.Config, opts []Option) (c *cluster, err error) {
+    o := options{healthCheckInterval: DefaultHealthCheckInterval}
+    for _, opt := range opts {
+        opt(&o)
+    }
+    connector, err := mysql.NewConnector(cfg)
+    if err != nil {
+        return
+    }
+    c = &cluster{
+        primary: sql.OpenDB(connector),
+        policy:  o.policy,
+        stop:    make(chan struct{}),
+    }
+    for _, replicaCfg := range o.replicas {
+        if replicaCfg == nil {
+            c.close()
+            return nil, errors.New("replica mysql config is nil")
+        }
+        connector, err = mysql.NewConnector(replicaCfg)
+        if err != nil {
+            c.close()
+            return nil, err
+        }
+        r := &replica{db: sql.OpenDB(connector)}
+        r.healthy.Store(true)
+        c.replicas = append(c.replicas, r)
+    }
+    for _, setup := range o.setups {
+        if err = setup(name, c); err != nil {
+            c.close()
+            return nil, err
+        }
+    }
+    if len(c.replicas) > 0 && o.healthCheckInterval > 0 {
+        c.wg.Add(1)
+        go c.healthCheck(o.healthCheckInterval)
+    }
+    return
+}
+
+// reader returns a healthy replica, or the primary if there is none.
+func (c *cluster) reader() *sql.DB {
+    var healthy []*replica
+    for _, r := range c.replicas {
+        if r.healthy.Load() {
+            healthy = append(healthy, r)
+        }
+    }
+    if len(healthy) == 0 {
+        return c.primary
+    }
+    if c.policy == LeastConnections {
+        best := healthy[0]
+        for _, r := range healthy[1:] {
+            if r.db.Stats().InUse < best.db.Stats().InUse {
+                best = r
+            }
+        }
+        return best.db
+    }
+    return healthy[c.next.Add(1)%uint64(len(healthy))].db
+}
+
+// healthCheck pings the replicas periodically until the cluster is closed.
+func (c *cluster) healthCheck(interval time.Duration) {
+    defer c.wg.Done()
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+    for {
+        select {
+        case <-c.stop:
+            return
+        case <-ticker.C:
+        }
+        for _, r := range c.replicas {
+            ctx, cancel := context.WithTimeout(context.Background(), interval)
+            r.healthy.Store(r.db.PingContext(ctx) == nil)
+            cancel()
+        }
+    }
+}
+
+func (c *cluster) close() {
+    for _, closer := range c.closers {
+        closer()
+    }
+    close(c.stop)
+    c.wg.Wait()
+    for _, r := range c.replicas {
+        r.db.Close()
+    }
+    c.primary.Close()
+}
+
+{{- if .Sharding }}
+
+var (
+    // ErrShardKeyRequired is returned when an operation on a sharded table has no shard key value or condition.
+    ErrShardKeyRequired = errors.New("shard key is required")
+    // ErrShardKeyUpdated is returned when an update of a sharded table changes the shard key,
+    // which would move the records to another shard.
+    ErrShardKeyUpdated = errors.New("shard key cannot be updated")
+)
+
+// Shard represents a physical table of a sharded table.
+type Shard struct {
+    Database string
+    Table    string
+}
+
+// ShardResolver resolves the index of the shard of a shard key value among n shards.
+type ShardResolver interface {
+    Resolve(key any, n int) (int, error)
+}
+
+// ModuloResolver resolves integer keys by key % n, negative keys by their absolute value.
+type ModuloResolver struct{}
+
+// Resolve implements ShardResolver.
+func (ModuloResolver) Resolve(key any, n int) (int, error) {
+    v, err := shardKeyUint(key)
+    if err != nil {
+        return 0, err
+    }
+    return int(v % uint64(n)), nil
+}
+
+// HashResolver resolves keys of any type by the FNV-1a hash of their string form.
+type HashResolver struct{}
+
+// Resolve implements ShardResolver.
+func (HashResolver) Resolve(key any, n int) (int, error) {
+    h := fnv.New64a()
+    fmt.Fprint(h, key)
+    return int(h.Sum64() % uint64(n)), nil
+}
+
+// RangeResolver resolves integer keys by ranges, Bounds[i] is the exclusive upper bound of shard i.
+// Keys from the last bound belong to the next shard, e.g. Bounds {1000, 2000} for 3 shards.
+type RangeResolver struct {
+    Bounds []int64
+}
+
+// Resolve implements ShardResolver.
+func (r RangeResolver) Resolve(key any, n int) (int, error) {
+    v, err := shardKeyInt(key)
+    if err != nil {
+        return 0, err
+    }
+    for i, bound := range r.Bounds {
+        if v < bound {
+            return i, nil
+        }
+    }
+    if len(r.Bounds) < n {
+        return len(r.Bounds), nil
+    }
+    return 0, fmt.Errorf("shard key %v exceeds the range bounds", key)
+}
+
+// DateUnit specifies the period of time covered by a shard.
+type DateUnit int
+
+const (
+    DateUnitDay DateUnit = iota
+    DateUnitMonth
+    DateUnitYear
+)
+
+// DateResolver resolves time.Time keys by the periods elapsed since Start, e.g. one shard per month.
+type DateResolver struct {
+    Start time.Time
+    Unit  DateUnit
+}
+
+// Resolve implements ShardResolver.
+func (r DateResolver) Resolve(key any, n int) (int, error) {
+    t, ok := key.(time.Time)
+    if !ok {
+        return 0, fmt.Errorf("shard key %v is not a time.Time", key)
+    }
+    t = t.In(r.Start.Location())
+    var index int
+    switch r.Unit {
+    case DateUnitDay:
+        start := time.Date(r.Start.Year(), r.Start.Month(), r.Start.Day(), 0, 0, 0, 0, r.Start.Location())
+        index = int(t.Sub(start) / (24 * time.Hour))
+        if t.Before(start) {
+            index = -1
+        }
+    case DateUnitMonth:
+        index = (t.Year()-r.Start.Year())*12 + int(t.Month()) - int(r.Start.Month())
+    case DateUnitYear:
+        index = t.Year() - r.Start.Year()
+    default:
+        return 0, fmt.Errorf("invalid date unit %d", r.Unit)
+    }
+    if index < 0 || index >= n {
+        return 0, fmt.Errorf("shard key %v is out of the date range", key)
+    }
+    return index, nil
+}
+
+// shardKeyInt converts an integer or numeric string shard key to int64.
+func shardKeyInt(key any) (int64, error) {
+    rv := reflect.ValueOf(key)
+    switch rv.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return rv.Int(), nil
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        if v := rv.Uint(); v <= 1<<63-1 {
+            return int64(v), nil
+        }
+    case reflect.String:
+        if v, err := strconv.ParseInt(rv.String(), 10, 64); err == nil {
+            return v, nil
+        }
+    }
+    return 0, fmt.Errorf("shard key %v is not an integer", key)
+}
+
+// shardKeyUint converts an integer or numeric string shard key to uint64, negative keys to their absolute value.
+func shardKeyUint(key any) (uint64, error) {
+    rv := reflect.ValueOf(key)
+    switch rv.Kind() {
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return rv.Uint(), nil
+    case reflect.String:
+        if v, err := strconv.ParseUint(rv.String(), 10, 64); err == nil {
+            return v, nil
+        }
+    }
+    v, err := shardKeyInt(key)
+    if err != nil {
+        return 0, err
+    }
+    if v < 0 {
+        return uint64(-(v + 1)) + 1, nil
+    }
+    return uint64(v), nil
+}
+{{- end }}
+
+// ErrFakeUnsupported is returned by the fake repositories for the operations they cannot evaluate.
+var ErrFakeUnsupported = errors.New("operation is not supported by the fake repository")
+
+// fakeFieldValue returns the field of the column in the entity pointer by the db tag.
+func fakeFieldValue(entity any, column string) (reflect.Value, bool) {
+    rv := reflect.ValueOf(entity).Elem()
+    rt := rv.Type()
+    for i := 0; i < rt.NumField(); i++ {
+        if strings.TrimSpace(strings.Split(rt.Field(i).Tag.Get("db"), ",")[0]) == column {
+            return rv.Field(i), true
+        }
+    }
+    return reflect.Value{}, false
+}
+
+// fakeField returns the value of the column in the entity pointer, nil if there is no such column.
+func fakeField(entity any, column string) any {
+    field, ok := fakeFieldValue(entity, column)
+    if !ok {
+        return nil
+    }
+    return field.Interface()
+}
+
+// setFakeField sets the column in the entity pointer to val like the driver would store and scan it.
+func setFakeField(entity any, column string, val any) error {
+    field, ok := fakeFieldValue(entity, column)
+    if !ok {
+        return fmt.Errorf("unknown column %q", column)
+    }
+    return assignFake(field, val)
+}
+
+func assignFake(field reflect.Value, val any) error {
+    if val == nil {
+        field.SetZero()
+        return nil
+    }
+    v := reflect.ValueOf(val)
+    if v.Type().AssignableTo(field.Type()) {
+        field.Set(v)
+        return nil
+    }
+    if field.Kind() == reflect.Pointer {
+        elem := reflect.New(field.Type().Elem())
+        if err := assignFake(elem.Elem(), val); err != nil {
+            return err
+        }
+        field.Set(elem)
+        return nil
+    }
+    if scanner, ok := field.Addr().Interface().(sql.Scanner); ok {
+        if valuer, ok := val.(driver.Valuer); ok {
+            var err error
+            if val, err = valuer.Value(); err != nil {
+                return err
+            }
+        }
+        return scanner.Scan(val)
+    }
+    isInt := func(k reflect.Kind) bool {
+        return k >= reflect.Int && k <= reflect.Uint64
+    }
+    // Converting integers to strings would yield runes
+    if v.Type().ConvertibleTo(field.Type()) && !(field.Kind() == reflect.String && isInt(v.Kind())) {
+        field.Set(v.Convert(field.Type()))
+        return nil
+    }
+    return fmt.Errorf("cannot assign %T to a column of type %s", val, field.Type())
+}
+
+// fakeEqual reports whether a equals b, by the method a.Equal(b) if any, e.g. of time.Time and decimal.Decimal.
+func fakeEqual(a, b any) bool {
+    av := reflect.ValueOf(a)
+    if av.IsValid() && !(av.Kind() == reflect.Pointer && av.IsNil()) {
+        if m := av.MethodByName("Equal"); m.IsValid() {
+            mt := m.Type()
+            if mt.NumIn() == 1 && mt.NumOut() == 1 && mt.Out(0).Kind() == reflect.Bool && reflect.TypeOf(b) == mt.In(0) {
+                return m.Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+            }
+        }
+    }
+    return reflect.DeepEqual(a, b)
+}
+
+// fakeIsNull reports whether v is stored as NULL.
+func fakeIsNull(v any) bool {
+    if v == nil {
+        return true
+    }
+    if rv := reflect.ValueOf(v); (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Slice) && rv.IsNil() {
+        return true
+    }
+    if valuer, ok := v.(driver.Valuer); ok {
+        value, err := valuer.Value()
+        return err == nil && value == nil
+    }
+    return false
+}
+
+// fakeInt returns the integer value of v.
+func fakeInt(v any) (int64, bool) {
+    rv := reflect.ValueOf(v)
+    switch rv.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return rv.Int(), true
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return int64(rv.Uint()), true
+    }
+    return 0, false
+}
+
+// fakeCompare compares integers, floats and strings, and returns 0 for other values.
+func fakeCompare(a, b any) int {
+    av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+    if av.Kind() != bv.Kind() {
+        return 0
+    }
+    switch av.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return cmp.Compare(av.Int(), bv.Int())
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return cmp.Compare(av.Uint(), bv.Uint())
+    case reflect.Float32, reflect.Float64:
+        return cmp.Compare(av.Float(), bv.Float())
+    case reflect.String:
+        return strings.Compare(av.String(), bv.String())
     }
+    return 0
 }
 
-// InitTableFields initializes the field names list from a table entity.  
+// InitTableFields initializes the field names list from the db tags of a table entity,
+// the other struct tags of the fields are ignored.
 func InitTableFields(v any, fields *[]string) {
 	t := reflect.TypeOf(v)
 	*fields = make([]string, 0, t.NumField())
@@ -59,7 +1240,8 @@ func InitTableFields(v any, fields *[]string) {
 	}
 }
 
-// InitTableAlias initializes the alias of fields from a table entity.
+// InitTableAlias initializes the alias of fields from the db tags of a table entity,
+// the other struct tags of the fields are ignored.
 func InitTableAlias(v any, alias any) {
 	rt := reflect.TypeOf(v)
 	rv := reflect.ValueOf(alias)
@@ -0,0 +1,184 @@
+// This file was generated by go-dao-code-gen
+
+package {{ .Pkg }}
+
+import (
+	"context"
+	{{- if .Types.Geometry }}
+	"encoding/binary"
+	{{- end }}
+	"fmt"
+	{{- if .Types.Geometry }}
+	"math"
+	{{- end }}
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	{{- if .Metrics }}
+	"github.com/prometheus/client_golang/prometheus"
+	{{- end }}
+)
+
+// testDSNEnv specifies the environment variable of the DSN of the database the table tests run against.
+// The tests insert and delete fixture rows, so use a dedicated database with the schema of the tables.
+// The tests are skipped if the variable is empty.
+const testDSNEnv = "DAO_TEST_DSN"
+
+var (
+	// testConfig specifies the configuration of the test database, nil if it is not configured.
+	testConfig *mysql.Config
+	// fixtureSeed makes the fixture values of a test run different from the rows of other runs.
+	fixtureSeed = time.Now().UnixNano()
+	// fixtureTime specifies the value of the time columns of the fixture rows.
+	fixtureTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if dsn := os.Getenv(testDSNEnv); dsn != "" {
+		cfg, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "parse %s failed, %v\n", testDSNEnv, err)
+			os.Exit(1)
+		}
+		cfg.ParseTime = true
+		// Update returns the matched rows rather than the changed ones
+		cfg.ClientFoundRows = true
+		// The fixture rows do not reference the rows of other tables
+		if cfg.Params == nil {
+			cfg.Params = make(map[string]string)
+		}
+		if _, ok := cfg.Params["foreign_key_checks"]; !ok {
+			cfg.Params["foreign_key_checks"] = "0"
+		}
+		if err = initTestDB(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "init test database failed, %v\n", err)
+			os.Exit(1)
+		}
+		testConfig = cfg
+	}
+	code := m.Run()
+	Close()
+	os.Exit(code)
+}
+
+// initTestDB initializes the connection of the test database, which is registered for every database
+// of the tables, so that the tables of several databases are tested in the test database.
+func initTestDB(cfg *mysql.Config) error {
+	if err := Init(context.Background(), cfg); err != nil {
+		return err
+	}
+	for _, database := range []string{ {{- range $i, $db := .Databases }}{{ if $i }}, {{ end }}"{{ $db }}"{{ end -}} } {
+		if database == cfg.DBName {
+			continue
+		}
+		if err := Register(context.Background(), database, cfg); err != nil {
+			return fmt.Errorf("register %s, %w", database, err)
+		}
+	}
+	return nil
+}
+
+// requireTestDB skips the test if the test database is not configured.
+func requireTestDB(t *testing.T) {
+	t.Helper()
+	if testConfig == nil {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+}
+
+// fixtureNull returns NULL for the odd fixture rows, and v for the others.
+func fixtureNull(n int, v any) any {
+	if n%2 == 1 {
+		return nil
+	}
+	return v
+}
+
+// fixtureInt returns an integer in [0, maxValue] of the n-th fixture row.
+func fixtureInt(n int, maxValue int64) int64 {
+	return (fixtureSeed%maxValue + int64(n)) % (maxValue + 1)
+}
+
+// fixtureString returns a string of at most size characters of the n-th fixture row.
+func fixtureString(n int, size int) string {
+	s := strconv.FormatInt(fixtureSeed+int64(n), 36)
+	if len(s) > size {
+		// Keep the trailing characters, which differ between the rows
+		s = s[len(s)-size:]
+	}
+	return s
+}
+
+// fixtureDecimal returns a decimal of the precision and scale of the n-th fixture row.
+func fixtureDecimal(n, precision, scale int) string {
+	s := "0"
+	if precision > scale {
+		s = strconv.Itoa(n % 10)
+	}
+	if scale > 0 {
+		s += "." + strings.Repeat("5", scale)
+	}
+	return s
+}
+
+// fixtureJSON returns a JSON document of the n-th fixture row.
+func fixtureJSON(n int) string {
+	return `{"n": ` + strconv.Itoa(n) + `}`
+}
+{{- if .Types.Geometry }}
+
+// fixtureWKB returns the well-known binary of a geometry of the spatial type.
+func fixtureWKB(spatialType string) []byte {
+	point := func(b []byte, x, y float64) []byte {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(x))
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(y))
+	}
+	geometry := func(typ uint32, body []byte) []byte {
+		b := binary.LittleEndian.AppendUint32([]byte{1}, typ)
+		return append(b, body...)
+	}
+	count := func(n uint32, body []byte) []byte {
+		return append(binary.LittleEndian.AppendUint32(nil, n), body...)
+	}
+	lineString := count(2, point(point(nil, 0, 0), 1, 1))
+	polygon := count(1, count(4, point(point(point(point(nil, 0, 0), 1, 0), 0, 1), 0, 0)))
+	switch spatialType {
+	case "linestring":
+		return geometry(2, lineString)
+	case "polygon":
+		return geometry(3, polygon)
+	case "multipoint":
+		return geometry(4, count(1, geometry(1, point(nil, 1, 1))))
+	case "multilinestring":
+		return geometry(5, count(1, geometry(2, lineString)))
+	case "multipolygon":
+		return geometry(6, count(1, geometry(3, polygon)))
+	case "geometrycollection", "geomcollection":
+		return geometry(7, count(1, geometry(1, point(nil, 1, 1))))
+	}
+	return geometry(1, point(nil, 1, 1))
+}
+{{- end }}
+{{- if .Metrics }}
+
+// TestMetricsReinit tests that the connections initialized with WithMetrics are initialized again after Close,
+// i.e. Close unregisters the pool metrics of the connections.
+func TestMetricsReinit(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	for i := range 2 {
+		if err := Init(context.Background(), mysql.NewConfig(), WithMetrics(reg)); err != nil {
+			t.Fatalf("Init() #%d error = %v", i+1, err)
+		}
+		Close()
+	}
+	if testConfig != nil {
+		if err := initTestDB(testConfig); err != nil {
+			t.Fatalf("init test database failed, %v", err)
+		}
+	}
+}
+{{- end }}
@@ -0,0 +1,170 @@
+// This file was generated by go-dao-code-gen
+
+package {{ .Pkg }}
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsNamespace specifies the namespace of DAO metrics.
+const MetricsNamespace = "dao"
+
+var (
+    metricsMu sync.Mutex
+    registries = make(map[prometheus.Registerer]*metricsHook) // registry -> hook
+)
+
+// WithMetrics registers the metrics of DAO operations and connection pools in reg:
+//
+//   - dao_operations_total{db,table,operation}
+//   - dao_operation_duration_seconds{db,table,operation}
+//   - dao_operation_errors_total{db,table,operation,code}, code is the MySQL error number or "other"
+//   - dao_pool_*{db,pool} gauges and counters of sql.DBStats, pool is primary or replicaN
+//
+// The operation metrics are registered once per registry and observe the operations of all DAOs.
+// The pool metrics are unregistered by Close.
+func WithMetrics(reg prometheus.Registerer) Option {
+    return func(o *options) {
+        o.setups = append(o.setups, func(name string, c *cluster) error {
+            if err := registerOperationMetrics(reg); err != nil {
+                return err
+            }
+            collector := newPoolCollector(name, c)
+            if err := reg.Register(collector); err != nil {
+                return err
+            }
+            c.closers = append(c.closers, func() {
+                reg.Unregister(collector)
+            })
+            return nil
+        })
+    }
+}
+
+// registerOperationMetrics registers the operation metrics in reg and adds their hook, once per registry.
+func registerOperationMetrics(reg prometheus.Registerer) error {
+    metricsMu.Lock()
+    defer metricsMu.Unlock()
+    if _, ok := registries[reg]; ok {
+        return nil
+    }
+    labels := []string{"db", "table", "operation"}
+    h := &metricsHook{
+        operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+            Namespace: MetricsNamespace,
+            Name:      "operations_total",
+            Help:      "Total number of DAO operations.",
+        }, labels),
+        duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+            Namespace: MetricsNamespace,
+            Name:      "operation_duration_seconds",
+            Help:      "Duration of DAO operations in seconds.",
+            Buckets:   prometheus.DefBuckets,
+        }, labels),
+        errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+            Namespace: MetricsNamespace,
+            Name:      "operation_errors_total",
+            Help:      "Total number of failed DAO operations by MySQL error number.",
+        }, append(labels, "code")),
+    }
+    for _, collector := range []prometheus.Collector{h.operations, h.duration, h.errors} {
+        if err := reg.Register(collector); err != nil {
+            return err
+        }
+    }
+    registries[reg] = h
+    AddHook(h)
+    return nil
+}
+
+// metricsHook records the operation metrics of DAOs.
+type metricsHook struct {
+    operations *prometheus.CounterVec
+    duration   *prometheus.HistogramVec
+    errors     *prometheus.CounterVec
+}
+
+// BeforeQuery implements Hook.
+func (h *metricsHook) BeforeQuery(ctx context.Context, info *QueryInfo) context.Context {
+    return ctx
+}
+
+// AfterQuery implements Hook.
+func (h *metricsHook) AfterQuery(ctx context.Context, info *QueryInfo) {
+    h.operations.WithLabelValues(info.Database, info.Table, info.Operation).Inc()
+    h.duration.WithLabelValues(info.Database, info.Table, info.Operation).Observe(info.Duration.Seconds())
+    if info.Err != nil {
+        h.errors.WithLabelValues(info.Database, info.Table, info.Operation, errorCode(info.Err)).Inc()
+    }
+}
+
+// errorCode returns the MySQL error number of err, or "other".
+func errorCode(err error) string {
+    var mysqlErr *mysql.MySQLError
+    if errors.As(err, &mysqlErr) {
+        return strconv.Itoa(int(mysqlErr.Number))
+    }
+    return "other"
+}
+
+// poolCollector collects sql.DBStats of the connection pools of a cluster.
+type poolCollector struct {
+    cluster      *cluster
+    maxOpen      *prometheus.Desc
+    open         *prometheus.Desc
+    inUse        *prometheus.Desc
+    idle         *prometheus.Desc
+    waitCount    *prometheus.Desc
+    waitDuration *prometheus.Desc
+}
+
+func newPoolCollector(name string, c *cluster) *poolCollector {
+    labels := []string{"pool"}
+    constLabels := prometheus.Labels{"db": name}
+    desc := func(metric, help string) *prometheus.Desc {
+        return prometheus.NewDesc(MetricsNamespace+"_pool_"+metric, help, labels, constLabels)
+    }
+    return &poolCollector{
+        cluster:      c,
+        maxOpen:      desc("max_open_connections", "Maximum number of open connections to the database."),
+        open:         desc("open_connections", "Number of established connections, both in use and idle."),
+        inUse:        desc("in_use_connections", "Number of connections currently in use."),
+        idle:         desc("idle_connections", "Number of idle connections."),
+        waitCount:    desc("wait_count_total", "Total number of connections waited for."),
+        waitDuration: desc("wait_duration_seconds_total", "Total time blocked waiting for a new connection in seconds."),
+    }
+}
+
+// Describe implements prometheus.Collector.
+func (p *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+    ch <- p.maxOpen
+    ch <- p.open
+    ch <- p.inUse
+    ch <- p.idle
+    ch <- p.waitCount
+    ch <- p.waitDuration
+}
+
+// Collect implements prometheus.Collector.
+func (p *poolCollector) Collect(ch chan<- prometheus.Metric) {
+    p.collect(ch, "primary", p.cluster.primary.Stats())
+    for i, r := range p.cluster.replicas {
+        p.collect(ch, "replica"+strconv.Itoa(i), r.db.Stats())
+    }
+}
+
+func (p *poolCollector) collect(ch chan<- prometheus.Metric, pool string, stats sql.DBStats) {
+    ch <- prometheus.MustNewConstMetric(p.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections), pool)
+    ch <- prometheus.MustNewConstMetric(p.open, prometheus.GaugeValue, float64(stats.OpenConnections), pool)
+    ch <- prometheus.MustNewConstMetric(p.inUse, prometheus.GaugeValue, float64(stats.InUse), pool)
+    ch <- prometheus.MustNewConstMetric(p.idle, prometheus.GaugeValue, float64(stats.Idle), pool)
+    ch <- prometheus.MustNewConstMetric(p.waitCount, prometheus.CounterValue, float64(stats.WaitCount), pool)
+    ch <- prometheus.MustNewConstMetric(p.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds(), pool)
+}
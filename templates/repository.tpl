@@ -0,0 +1,464 @@
+// This file was generated by go-dao-code-gen.
+// You can modify it to be more suitable.
+
+package {{ .Pkg }}
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+    {{- if or (ne .TimeFields.CreateTime "") (ne .TimeFields.UpdateTime "") }}
+	"time"
+    {{- end }}
+)
+
+// {{ .TableUpperCamelIdent }}Repository specifies the operations on the {{ .Table }} table, which are implemented
+// by {{ .TableUpperCamelIdent }}Dao and by Fake{{ .TableUpperCamelIdent }}Repository for unit tests.
+type {{ .TableUpperCamelIdent }}Repository interface {
+	Insert(ctx context.Context, values map[string]any) (lastInsertID int64, err error)
+	InsertMany(ctx context.Context, valueList []map[string]any) (err error)
+	Get(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (*{{ .TableUpperCamelIdent }}Entity, error)
+	First(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (*{{ .TableUpperCamelIdent }}Entity, error)
+	Count(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (total int, err error)
+	List(ctx context.Context, limit, offset int, conds ...{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error)
+	All(ctx context.Context, limit int, conds ...{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error)
+	Update(ctx context.Context, values map[string]any, conds ...{{ .TableUpperCamelIdent }}Cond) (total int64, err error)
+	Delete(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (total int64, err error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryReplica(query string, args ...any) (*sql.Rows, error)
+	Exec(query string, args ...any) (sql.Result, error)
+	{{- range .References }}
+	{{ .GetName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity) (*{{ .Parent.TableUpperCamelIdent }}Entity, error)
+	{{ .LoadName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}List []*{{ $.TableUpperCamelIdent }}Entity) (map[{{ .KeyType }}]*{{ .Parent.TableUpperCamelIdent }}Entity, error)
+	{{- end }}
+	{{- range .ReferencedBy }}
+	{{ .ListName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity, limit, offset int, conds ...{{ .Child.TableUpperCamelIdent }}Cond) ([]*{{ .Child.TableUpperCamelIdent }}Entity, error)
+	{{- end }}
+}
+
+var (
+	_ {{ .TableUpperCamelIdent }}Repository = (*{{ .TableUpperCamelIdent }}Dao)(nil)
+	_ {{ .TableUpperCamelIdent }}Repository = (*Fake{{ .TableUpperCamelIdent }}Repository)(nil)
+)
+
+// Fake{{ .TableUpperCamelIdent }}Repository is an in-memory {{ .TableUpperCamelIdent }}Repository for unit tests.
+// It evaluates the conditions against the stored entities like the SQL of {{ .TableUpperCamelIdent }}Dao, except for
+// time.Time columns, which are ignored by the conditions, and JSON conditions, which return ErrFakeUnsupported.
+// Query, QueryReplica and Exec return ErrFakeUnsupported.
+{{- if .RelatedTables }}
+// The relation methods filter the records of the fake repositories of the related tables, which are linked by
+// {{ range $i, $table := .RelatedTables }}{{ if $i }}, {{ end }}Set{{ $table.TableUpperCamelIdent }}Repository{{ end }}, and return ErrFakeUnsupported until then.
+{{- end }}
+type Fake{{ .TableUpperCamelIdent }}Repository struct {
+	mu      sync.Mutex
+	records []*{{ .TableUpperCamelIdent }}Entity
+	{{- if .Primary }}
+	lastID  int64
+	{{- end }}
+	{{- range .RelatedTables }}
+	{{ .TableLowerCamelIdent }}Repo *Fake{{ .TableUpperCamelIdent }}Repository
+	{{- end }}
+}
+
+// NewFake{{ .TableUpperCamelIdent }}Repository returns a fake repository storing copies of the entities.
+func NewFake{{ .TableUpperCamelIdent }}Repository(entities ...*{{ .TableUpperCamelIdent }}Entity) *Fake{{ .TableUpperCamelIdent }}Repository {
+	f := &Fake{{ .TableUpperCamelIdent }}Repository{}
+	for _, entity := range entities {
+		record := *entity
+		f.records = append(f.records, &record)
+		{{- if .Primary }}
+		if id, ok := fakeInt(fakeField(&record, "{{ .Primary }}")); ok && id > f.lastID {
+			f.lastID = id
+		}
+		{{- end }}
+	}
+	return f
+}
+
+// Insert implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Insert(ctx context.Context, values map[string]any) (lastInsertID int64, err error) {
+	if len(values) == 0 {
+		return lastInsertID, errors.New("param values cannot be empty")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.insert(values)
+}
+
+// InsertMany implements {{ .TableUpperCamelIdent }}Repository, the records are inserted all or none.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) InsertMany(ctx context.Context, valueList []map[string]any) (err error) {
+	if len(valueList) == 0 {
+		return
+	}
+	if len(valueList) > Max{{ .TableUpperCamelIdent }}Limit {
+		return fmt.Errorf("received %d data, exceeding the maximum %d limit", len(valueList), Max{{ .TableUpperCamelIdent }}Limit)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	records := f.records
+	{{- if .Primary }}
+	lastID := f.lastID
+	{{- end }}
+	for _, values := range valueList {
+		if len(values) == 0 {
+			err = errors.New("param values cannot be empty")
+		} else {
+			_, err = f.insert(values)
+		}
+		if err != nil {
+			f.records = records
+			{{- if .Primary }}
+			f.lastID = lastID
+			{{- end }}
+			return err
+		}
+	}
+	return nil
+}
+
+// insert stores a new record of the values.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) insert(values map[string]any) (lastInsertID int64, err error) {
+	record := &{{ .TableUpperCamelIdent }}Entity{}
+	{{- if .Shard }}
+	if _, ok := values[{{ .TableUpperCamelIdent }}ShardKey]; !ok {
+		return lastInsertID, ErrShardKeyRequired
+	}
+	{{- end }}
+	if err = checkColumnPolicies({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}ColumnPolicies, values, false); err != nil {
+		return
+	}
+	if err = validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, values, true); err != nil {
+		return
+	}
+	for _, field := range {{ .TableLowerCamelIdent }}Fields {
+		if val, ok := values[field]; ok {
+			if err = setFakeField(record, field, val); err != nil {
+				return
+			}
+		}
+	}
+	{{- if or (ne .TimeFields.CreateTime "") (ne .TimeFields.UpdateTime "") }}
+	curTime := time.Now()
+	{{- end }}
+	{{- if ne .TimeFields.CreateTime "" }}
+	if _, ok := values["{{ .TimeFields.CreateTime }}"]; !ok {
+		setFakeField(record, "{{ .TimeFields.CreateTime }}", curTime{{ if eq .TimeFields.CreateType "int" }}.Unix(){{ end }})
+	}
+	{{- end }}
+	{{- if ne .TimeFields.UpdateTime "" }}
+	if _, ok := values["{{ .TimeFields.UpdateTime }}"]; !ok {
+		setFakeField(record, "{{ .TimeFields.UpdateTime }}", curTime{{ if eq .TimeFields.UpdateType "int" }}.Unix(){{ end }})
+	}
+	{{- end }}
+	{{- if .Primary }}
+	if _, ok := values["{{ .Primary }}"]; !ok {
+		if _, ok := fakeInt(fakeField(record, "{{ .Primary }}")); ok {
+			setFakeField(record, "{{ .Primary }}", f.lastID+1)
+		}
+	}
+	if id, ok := fakeInt(fakeField(record, "{{ .Primary }}")); ok {
+		lastInsertID = id
+	}
+	{{- end }}
+	if err = f.checkUnique("Insert", record, nil); err != nil {
+		return 0, err
+	}
+	{{- if .Primary }}
+	if lastInsertID > f.lastID {
+		f.lastID = lastInsertID
+	}
+	{{- end }}
+	f.records = append(f.records, record)
+	return
+}
+
+// checkUnique returns ErrDuplicateKey if the record has the values of a unique index of another record than self.
+// NULL values are not duplicates like in MySQL.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) checkUnique(operation string, record, self *{{ .TableUpperCamelIdent }}Entity) error {
+	for index, columns := range {{ .TableLowerCamelIdent }}UniqueIndexes {
+		for _, other := range f.records {
+			if other == self {
+				continue
+			}
+			duplicate := true
+			for _, column := range columns {
+				value := fakeField(record, column)
+				if fakeIsNull(value) || !fakeEqual(value, fakeField(other, column)) {
+					duplicate = false
+					break
+				}
+			}
+			if duplicate {
+				return &Error{Table: {{ .TableUpperCamelIdent }}TableName, Operation: operation, Kind: ErrDuplicateKey, Index: index, Columns: columns}
+			}
+		}
+	}
+	return nil
+}
+
+// Get implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Get(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (*{{ .TableUpperCamelIdent }}Entity, error) {
+	list, err := f.find(1, 0, conds)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// First implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) First(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (*{{ .TableUpperCamelIdent }}Entity, error) {
+	{{ .TableLowerCamelIdent }}Entity, err := f.Get(ctx, conds...)
+	if err != nil {
+		return nil, err
+	}
+	if {{ .TableLowerCamelIdent }}Entity == nil {
+		return nil, &Error{Table: {{ .TableUpperCamelIdent }}TableName, Operation: "First", Kind: ErrNotFound}
+	}
+	return {{ .TableLowerCamelIdent }}Entity, nil
+}
+
+// Count implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Count(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (total int, err error) {
+	list, err := f.find(0, 0, conds)
+	return len(list), err
+}
+
+// List implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) List(ctx context.Context, limit, offset int, conds ...{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error) {
+	if limit <= 0 || limit > Max{{ .TableUpperCamelIdent }}Limit {
+		limit = Max{{ .TableUpperCamelIdent }}Limit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return f.find(limit, offset, conds)
+}
+
+// All implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) All(ctx context.Context, limit int, conds ...{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error) {
+	return f.find(limit, 0, conds)
+}
+
+// find returns copies of the records that meet the conditions ordered by {{ if .Primary }}{{ .Primary }} descending{{ else }}insertion{{ end }},
+// at most limit records if limit > 0.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) find(limit, offset int, conds []{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	records, err := f.match(conds)
+	if err != nil {
+		return nil, err
+	}
+	{{- if .Primary }}
+	slices.SortStableFunc(records, func(a, b *{{ .TableUpperCamelIdent }}Entity) int {
+		return fakeCompare(fakeField(b, "{{ .Primary }}"), fakeField(a, "{{ .Primary }}"))
+	})
+	{{- end }}
+	records = records[min(offset, len(records)):]
+	if limit > 0 {
+		records = records[:min(limit, len(records))]
+	}
+	list := make([]*{{ .TableUpperCamelIdent }}Entity, 0, len(records))
+	for _, record := range records {
+		entity := *record
+		list = append(list, &entity)
+	}
+	return list, nil
+}
+
+// match returns the stored records that meet the conditions{{ if .Shard }}, which require the shard key like {{ .TableUpperCamelIdent }}Dao{{ end }}.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) match(conds []{{ .TableUpperCamelIdent }}Cond) ([]*{{ .TableUpperCamelIdent }}Entity, error) {
+	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+	{{- if .Shard }}
+	if o.{{ .Shard.KeyName }} == nil {
+		return nil, ErrShardKeyRequired
+	}
+	{{- end }}
+	{{- range .Attrs }}
+	{{- if .IsJSON }}
+	if len(o.{{ .Name }}JSONConds) != 0 {
+		return nil, ErrFakeUnsupported
+	}
+	{{- end }}
+	{{- end }}
+	var records []*{{ .TableUpperCamelIdent }}Entity
+	for _, record := range f.records {
+		{{- range .Attrs }}
+		{{- if ne .Type "time.Time" }}
+		if o.{{ .Name }} != nil && !fakeEqual(record.{{ .Name }}, *o.{{ .Name }}) {
+			continue
+		}
+		{{- end }}
+		{{- end }}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Update implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Update(ctx context.Context, values map[string]any, conds ...{{ .TableUpperCamelIdent }}Cond) (total int64, err error) {
+	if len(conds) == 0 || len(values) == 0 {
+		return
+	}
+	if err = checkColumnPolicies({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}ColumnPolicies, values, true); err != nil {
+		return
+	}
+	if err = validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, values, false); err != nil {
+		return
+	}
+	{{- if .Shard }}
+	if _, ok := values[{{ .TableUpperCamelIdent }}ShardKey]; ok {
+		return total, ErrShardKeyUpdated
+	}
+	{{- end }}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	records, err := f.match(conds)
+	if err != nil {
+		return
+	}
+	{{- if ne .TimeFields.UpdateTime "" }}
+	curTime := time.Now()
+	{{- end }}
+	for _, record := range records {
+		updated := *record
+		for field, val := range values {
+			if err = setFakeField(&updated, field, val); err != nil {
+				return 0, err
+			}
+		}
+		{{- if ne .TimeFields.UpdateTime "" }}
+		setFakeField(&updated, "{{ .TimeFields.UpdateTime }}", curTime{{ if eq .TimeFields.UpdateType "int" }}.Unix(){{ end }})
+		{{- end }}
+		if err = f.checkUnique("Update", &updated, record); err != nil {
+			return total, err
+		}
+		*record = updated
+		total++
+	}
+	return
+}
+
+// Delete implements {{ .TableUpperCamelIdent }}Repository.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Delete(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (total int64, err error) {
+	if len(conds) == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	records, err := f.match(conds)
+	if err != nil {
+		return
+	}
+	f.records = slices.DeleteFunc(f.records, func(record *{{ .TableUpperCamelIdent }}Entity) bool {
+		return slices.Contains(records, record)
+	})
+	return int64(len(records)), nil
+}
+
+{{- range .RelatedTables }}
+
+// Set{{ .TableUpperCamelIdent }}Repository links the fake repository of the {{ .Table }} table read by the relation methods.
+func (f *Fake{{ $.TableUpperCamelIdent }}Repository) Set{{ .TableUpperCamelIdent }}Repository(repo *Fake{{ .TableUpperCamelIdent }}Repository) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.{{ .TableLowerCamelIdent }}Repo = repo
+}
+
+// {{ .TableLowerCamelIdent }}Repository returns the linked fake repository of the {{ .Table }} table,
+// or ErrFakeUnsupported if there is none.
+func (f *Fake{{ $.TableUpperCamelIdent }}Repository) {{ .TableLowerCamelIdent }}Repository() (*Fake{{ .TableUpperCamelIdent }}Repository, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.{{ .TableLowerCamelIdent }}Repo == nil {
+		return nil, fmt.Errorf("%w: the {{ .Table }} repository is not linked by Set{{ .TableUpperCamelIdent }}Repository", ErrFakeUnsupported)
+	}
+	return f.{{ .TableLowerCamelIdent }}Repo, nil
+}
+{{- end }}
+{{- range .References }}
+
+// {{ .GetName }} implements {{ $.TableUpperCamelIdent }}Repository.
+func (f *Fake{{ $.TableUpperCamelIdent }}Repository) {{ .GetName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity) (*{{ .Parent.TableUpperCamelIdent }}Entity, error) {
+	{{- if .IsNull }}
+	if {{ .IsNull }} {
+		return nil, nil
+	}
+	{{- end }}
+	{{- if .SelfReference }}
+	return f.Get(ctx, {{ .RefCond }}({{ .Key }}))
+	{{- else }}
+	repo, err := f.{{ .Parent.TableLowerCamelIdent }}Repository()
+	if err != nil {
+		return nil, err
+	}
+	return repo.Get(ctx, {{ .RefCond }}({{ .Key }}))
+	{{- end }}
+}
+
+// {{ .LoadName }} implements {{ $.TableUpperCamelIdent }}Repository.
+func (f *Fake{{ $.TableUpperCamelIdent }}Repository) {{ .LoadName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}List []*{{ $.TableUpperCamelIdent }}Entity) (map[{{ .KeyType }}]*{{ .Parent.TableUpperCamelIdent }}Entity, error) {
+	keys := make(map[{{ .KeyType }}]struct{}, len({{ $.TableLowerCamelIdent }}List))
+	for _, {{ $.TableLowerCamelIdent }}Entity := range {{ $.TableLowerCamelIdent }}List {
+		if {{ $.TableLowerCamelIdent }}Entity == nil{{ if .IsNull }} || {{ .IsNull }}{{ end }} {
+			continue
+		}
+		keys[{{ .Key }}] = struct{}{}
+	}
+	records := make(map[{{ .KeyType }}]*{{ .Parent.TableUpperCamelIdent }}Entity, len(keys))
+	if len(keys) == 0 {
+		return records, nil
+	}
+	{{- if .SelfReference }}
+	repo := f
+	{{- else }}
+	repo, err := f.{{ .Parent.TableLowerCamelIdent }}Repository()
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
+	list, err := repo.All(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, {{ .Parent.TableLowerCamelIdent }}Entity := range list {
+		if _, ok := keys[{{ .RefKey }}]; ok {
+			records[{{ .RefKey }}] = {{ .Parent.TableLowerCamelIdent }}Entity
+		}
+	}
+	return records, nil
+}
+{{- end }}
+{{- range .ReferencedBy }}
+
+// {{ .ListName }} implements {{ $.TableUpperCamelIdent }}Repository.
+func (f *Fake{{ $.TableUpperCamelIdent }}Repository) {{ .ListName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity, limit, offset int, conds ...{{ .Child.TableUpperCamelIdent }}Cond) ([]*{{ .Child.TableUpperCamelIdent }}Entity, error) {
+	value := {{ .Value }}
+	{{- if .SelfReference }}
+	repo := f
+	{{- else }}
+	repo, err := f.{{ .Child.TableLowerCamelIdent }}Repository()
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
+	return repo.List(ctx, limit, offset, append([]{{ .Child.TableUpperCamelIdent }}Cond{ {{- .Cond }}({{ .CondValue }})}, conds...)...)
+}
+{{- end }}
+
+// Query implements {{ .TableUpperCamelIdent }}Repository, it returns ErrFakeUnsupported.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Query(query string, args ...any) (*sql.Rows, error) {
+	return nil, ErrFakeUnsupported
+}
+
+// QueryReplica implements {{ .TableUpperCamelIdent }}Repository, it returns ErrFakeUnsupported.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) QueryReplica(query string, args ...any) (*sql.Rows, error) {
+	return nil, ErrFakeUnsupported
+}
+
+// Exec implements {{ .TableUpperCamelIdent }}Repository, it returns ErrFakeUnsupported.
+func (f *Fake{{ .TableUpperCamelIdent }}Repository) Exec(query string, args ...any) (sql.Result, error) {
+	return nil, ErrFakeUnsupported
+}
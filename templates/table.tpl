@@ -16,6 +16,8 @@ import (
 
 const ( // {{ .TableLowerCamelIdent }}TableName specifies the table name.
     {{ .TableUpperCamelIdent }}TableName = "{{ .Table }}"
+    // {{ .TableUpperCamelIdent }}Database specifies the database of the table, whose connection is registered by Init or Register.
+    {{ .TableUpperCamelIdent }}Database = "{{ .Database }}"
     // Max{{ .TableUpperCamelIdent }}Limit specifies the limit of insert and select operations.
 	Max{{ .TableUpperCamelIdent }}Limit int = 1000
 )
@@ -23,12 +25,42 @@ const ( // {{ .TableLowerCamelIdent }}TableName specifies the table name.
 var (
 	{{ .TableLowerCamelIdent }}Alias        {{ .TableUpperCamelIdent }}Alias
 	{{ .TableLowerCamelIdent }}Fields       []string
+	// {{ .TableLowerCamelIdent }}UniqueIndexes maps the unique indexes to their columns.
+	{{ .TableLowerCamelIdent }}UniqueIndexes = map[string][]string{
+    {{- range $name, $columns := .UniqueIndexes }}
+        "{{ $name }}": { {{- range $i, $column := $columns }}{{ if $i }}, {{ end }}"{{ $column }}"{{ end -}} },
+    {{- end }}
+    }
+	// {{ .TableLowerCamelIdent }}ColumnPolicies maps the columns which are not writable to their policies.
+	{{ .TableLowerCamelIdent }}ColumnPolicies = map[string]ColumnPolicy{
+    {{- range .Attrs }}
+        {{- if ne .Policy "writable" }}
+        "{{ .Tag }}": {{ .Policy.Const }},
+        {{- end }}
+    {{- end }}
+    }
+	// {{ .TableLowerCamelIdent }}Constraints lists the constraints of the columns derived from their definitions.
+	{{ .TableLowerCamelIdent }}Constraints = []columnConstraint{
+    {{- range .Constraints }}
+        {column: "{{ .Column }}"
+            {{- if .NotNull }}, notNull: true{{ end }}
+            {{- if .Required }}, required: true{{ end }}
+            {{- if .MaxLength }}, maxLength: {{ .MaxLength }}{{ end }}
+            {{- if .Ranged }}, ranged: true, min: {{ .Min }}, max: {{ .Max }}{{ end }}
+            {{- if .Values }}, values: []string{ {{- range $i, $value := .Values }}{{ if $i }}, {{ end }}{{ printf "%q" $value }}{{ end -}} }{{ end }}
+            {{- if .IsSet }}, isSet: true{{ end }}},
+    {{- end }}
+    }
 )
 
 // {{ .TableUpperCamelIdent }}Dao specifies the DAO object.
 type {{ .TableUpperCamelIdent }}Dao struct {
 	db          *sql.DB
+    cluster     *cluster
     forceMaster bool
+    hooks       []Hook
+    retryPolicy RetryPolicy
+    err         error // no connection is registered for the database, returned by the operations
     *{{ .TableUpperCamelIdent }}Alias
 }
 
@@ -40,31 +72,283 @@ type {{ .TableUpperCamelIdent }}Alias struct {
 }
 
 // {{ .TableUpperCamelIdent }}Entity represents the {{ .Table }} table mapping. 
-// Please manually remove the update tag from fields that are not allowed to be modified.
+{{- if .Comment }}
+// {{ .Comment }}
+{{- end }}
+// Insert and Update reject the columns which are not writable, see {{ .TableUpperCamelIdent }}ColumnPolicy,
+// and the values violating the constraints of the columns, see Validate.
 type {{ .TableUpperCamelIdent }}Entity struct {
 {{- range .Attrs }}
-        {{ .Name }} {{ .Type }} `db:"{{ .Tag }}"` {{ if .Comment -}}// {{ .Comment }} {{- end }} 
+        {{ .Name }} {{ .Type }} `db:"{{ .Tag }}"{{ range .Tags }} {{ .Key }}:"{{ .Value }}"{{ end }}` {{ if .Comment -}}// {{ .Comment }} {{- end }} 
+{{- end }}
+}
+
+// Validate returns a *ValidationError listing every field violating the constraints of its column: NOT NULL,
+// the length in characters of CHAR and VARCHAR columns, the range of integer columns and the values of ENUM,
+// SET and @enum columns. The fields of read-only columns are not validated.
+func (e *{{ .TableUpperCamelIdent }}Entity) Validate() error {
+    return validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, map[string]any{
+    {{- range .Constraints }}
+        "{{ .Column }}": e.{{ .Field }},
+    {{- end }}
+    }, false)
+}
+
+{{- range $enum := .Enums }}
+{{- if .IsSet }}
+
+// {{ .Name }} represents the values of the SET column {{ $.Table }}.{{ .Column }} as a bitset.
+type {{ .Name }} uint64
+
+// Members of the SET column {{ $.Table }}.{{ .Column }}.
+const (
+{{- range $i, $v := .Values }}
+    {{ $v.Name }} {{ if eq $i 0 }}{{ $enum.Name }} = 1 << iota{{ end }} // {{ printf "%q" $v.Value }}
+{{- end }}
+)
+
+var {{ lowerFirst .Name }}Members = []struct {
+    Member {{ .Name }}
+    Name   string
+}{
+{{- range .Values }}
+    { {{ .Name }}, {{ printf "%q" .Value }} },
+{{- end }}
+}
+
+// Parse{{ .Name }} parses a comma-separated list of SET members.
+func Parse{{ .Name }}(s string) ({{ .Name }}, error) {
+    var set {{ .Name }}
+    if s == "" {
+        return set, nil
+    }
+next:
+    for _, name := range strings.Split(s, ",") {
+        for _, m := range {{ lowerFirst .Name }}Members {
+            if m.Name == name {
+                set |= m.Member
+                continue next
+            }
+        }
+        return set, fmt.Errorf("invalid {{ .Name }} member %q", name)
+    }
+    return set, nil
+}
+
+// Has reports whether all the members are in the set.
+func (s {{ .Name }}) Has(members {{ .Name }}) bool {
+    return s&members == members
+}
+
+// Add adds the members to the set.
+func (s *{{ .Name }}) Add(members ...{{ .Name }}) {
+    for _, m := range members {
+        *s |= m
+    }
+}
+
+// Remove removes the members from the set.
+func (s *{{ .Name }}) Remove(members ...{{ .Name }}) {
+    for _, m := range members {
+        *s &^= m
+    }
+}
+
+// IsValid reports whether the set only contains the members of the column.
+func (s {{ .Name }}) IsValid() bool {
+    var all {{ .Name }}
+    for _, m := range {{ lowerFirst .Name }}Members {
+        all |= m.Member
+    }
+    return s&^all == 0
+}
+
+// String returns the members as a comma-separated list, the format used by MySQL.
+func (s {{ .Name }}) String() string {
+    names := make([]string, 0, len({{ lowerFirst .Name }}Members))
+    for _, m := range {{ lowerFirst .Name }}Members {
+        if s.Has(m.Member) {
+            names = append(names, m.Name)
+        }
+    }
+    return strings.Join(names, ",")
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *{{ .Name }}) Scan(src any) (err error) {
+    switch v := src.(type) {
+    case nil:
+        *s = 0
+    case []byte:
+        *s, err = Parse{{ .Name }}(string(v))
+    case string:
+        *s, err = Parse{{ .Name }}(v)
+    default:
+        err = fmt.Errorf("cannot scan %T into {{ .Name }}", src)
+    }
+    return
+}
+
+// Value implements the driver.Valuer interface.
+func (s {{ .Name }}) Value() (driver.Value, error) {
+    if !s.IsValid() {
+        return nil, fmt.Errorf("invalid {{ .Name }} value %d", uint64(s))
+    }
+    return s.String(), nil
+}
+{{- else if .Underlying }}
+
+// {{ .Name }} represents the values of the column {{ $.Table }}.{{ .Column }}.
+type {{ .Name }} {{ .Underlying }}
+
+// Values of the column {{ $.Table }}.{{ .Column }}.
+const (
+{{- range .Values }}
+    {{ .Name }} {{ $enum.Name }} = {{ .Value }}
 {{- end }}
+)
+
+// {{ .Name }}Values returns all the values of the column in definition order.
+func {{ .Name }}Values() []{{ .Name }} {
+    return []{{ .Name }}{ {{- range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $v.Name }}{{ end -}} }
+}
+
+// IsValid reports whether the value is one of the values of the column.
+func (e {{ .Name }}) IsValid() bool {
+    switch e {
+    case {{ range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $v.Name }}{{ end }}:
+        return true
+    }
+    return false
+}
+
+// String implements the fmt.Stringer interface.
+func (e {{ .Name }}) String() string {
+    switch e {
+    {{- range .Values }}
+    case {{ .Name }}:
+        return {{ printf "%q" .Label }}
+    {{- end }}
+    }
+    return fmt.Sprintf("{{ .Name }}(%d)", {{ .Underlying }}(e))
+}
+{{- else }}
+
+// {{ .Name }} represents the values of the ENUM column {{ $.Table }}.{{ .Column }}.
+type {{ .Name }} string
+
+// Values of the ENUM column {{ $.Table }}.{{ .Column }}.
+const (
+{{- range .Values }}
+    {{ .Name }} {{ $enum.Name }} = {{ printf "%q" .Value }}
+{{- end }}
+)
+
+// {{ .Name }}Values returns all the values of the column in definition order.
+func {{ .Name }}Values() []{{ .Name }} {
+    return []{{ .Name }}{ {{- range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $v.Name }}{{ end -}} }
+}
+
+// IsValid reports whether the value is allowed by the column.
+func (e {{ .Name }}) IsValid() bool {
+    switch e {
+    case {{ range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ $v.Name }}{{ end }}:
+        return true
+    }
+    return false
+}
+
+// String implements the fmt.Stringer interface.
+func (e {{ .Name }}) String() string {
+    return string(e)
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *{{ .Name }}) Scan(src any) error {
+    switch v := src.(type) {
+    case nil:
+        *e = ""
+    case []byte:
+        *e = {{ .Name }}(v)
+    case string:
+        *e = {{ .Name }}(v)
+    default:
+        return fmt.Errorf("cannot scan %T into {{ .Name }}", src)
+    }
+    return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e {{ .Name }}) Value() (driver.Value, error) {
+    if !e.IsValid() {
+        return nil, fmt.Errorf("invalid {{ .Name }} value %q", string(e))
+    }
+    return string(e), nil
+}
+{{- end }}
+{{- end }}
+
 func init() {
     InitTableAlias({{ .TableUpperCamelIdent }}Entity{}, &{{ .TableLowerCamelIdent }}Alias)
 	InitTableFields({{ .TableUpperCamelIdent }}Entity{}, &{{ .TableLowerCamelIdent }}Fields)
 }
 
-// New{{ .TableUpperCamelIdent }}Dao creates a new table object.
+// New{{ .TableUpperCamelIdent }}Dao creates a new table object on the connection of {{ .TableUpperCamelIdent }}Database.
+// If no connection is registered for the database, the operations return the error until UseConn is called.
 func New{{ .TableUpperCamelIdent }}Dao() *{{ .TableUpperCamelIdent }}Dao {
-	return &{{ .TableUpperCamelIdent }}Dao{
-		db: globalDB,
+	d := &{{ .TableUpperCamelIdent }}Dao{
+        retryPolicy: DefaultRetryPolicy,
         {{ .TableUpperCamelIdent }}Alias: &{{ .TableLowerCamelIdent }}Alias,
 	}
+    d.cluster, d.err = getCluster({{ .TableUpperCamelIdent }}Database)
+    if d.cluster != nil {
+        d.db = d.cluster.primary
+    }
+    return d
+}
+
+// {{ .TableUpperCamelIdent }}ColumnPolicy returns the policy of a column of the {{ .Table }} table.
+func {{ .TableUpperCamelIdent }}ColumnPolicy(column string) ColumnPolicy {
+    return {{ .TableLowerCamelIdent }}ColumnPolicies[column]
+}
+{{- if .Shard }}
+
+// {{ .TableUpperCamelIdent }}ShardKey specifies the column which routes the operations to a shard of the table.
+// Every operation requires a shard key value or condition.
+const {{ .TableUpperCamelIdent }}ShardKey = "{{ .Shard.Key }}"
+
+// {{ .TableLowerCamelIdent }}Shards lists the physical tables of {{ .Table }} ordered by table suffix.
+var {{ .TableLowerCamelIdent }}Shards = []Shard{
+{{- range .Shard.Shards }}
+    {Database: "{{ .Database }}", Table: "{{ .Name }}"},
+{{- end }}
 }
 
+var {{ .TableLowerCamelIdent }}ShardResolver ShardResolver = ModuloResolver{}
+
+// Set{{ .TableUpperCamelIdent }}ShardResolver sets the resolver of the shard of a shard key value, ModuloResolver by default.
+// It is not safe to call it concurrently with operations on the table.
+func Set{{ .TableUpperCamelIdent }}ShardResolver(resolver ShardResolver) {
+    {{ .TableLowerCamelIdent }}ShardResolver = resolver
+}
+
+// {{ .TableUpperCamelIdent }}Shards returns the physical tables of {{ .Table }} ordered by table suffix.
+func {{ .TableUpperCamelIdent }}Shards() []Shard {
+    return append([]Shard(nil), {{ .TableLowerCamelIdent }}Shards...)
+}
+{{- end }}
+
 // Insert inserts one data record.
 func (d *{{ .TableUpperCamelIdent }}Dao) Insert(ctx context.Context, values map[string]any)(lastInsertID int64, err error){
     if len(values) == 0 {
         return lastInsertID, errors.New("param values cannot be empty")
     }
+    if err = checkColumnPolicies({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}ColumnPolicies, values, false); err != nil {
+        return
+    }
+    if err = validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, values, true); err != nil {
+        return
+    }
     cols := make([]string, 0, len(values))
     vals := make([]any, 0, len(values))
     for _, field := range {{ .TableLowerCamelIdent }}Fields {
@@ -76,6 +360,16 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Insert(ctx context.Context, values map[
     if len(cols) == 0 {
         return lastInsertID, errors.New("no valid field data found")
     }
+    {{- if .Shard }}
+    key, ok := values[{{ .TableUpperCamelIdent }}ShardKey]
+    if !ok {
+        return lastInsertID, ErrShardKeyRequired
+    }
+    conn, table, err := d.shard(ctx, key, false)
+    if err != nil {
+        return
+    }
+    {{- end }}
     {{- if or (ne .TimeFields.CreateTime "") (ne .TimeFields.UpdateTime "")}}
     curTime := time.Now()
     {{- end }}
@@ -100,14 +394,14 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Insert(ctx context.Context, values map[
     }
     {{- end }}
     ib := sqlbuilder.NewInsertBuilder()
-    ib.InsertInto({{ .TableUpperCamelIdent }}TableName)
+    ib.InsertInto({{ template "table" . }})
     ib.Cols(cols...)
     ib.Values(vals...)
     sql, args := ib.Build()
-	result, err := d.db.Exec(sql,args...)
+    markWritten(ctx)
+	result, err := d.exec(ctx, {{ template "writer" . }}, "Insert", d.retryPolicy.IdempotentInserts, sql, args)
 	if err != nil{
-        // TODO: log error
-		return lastInsertID,err 
+		return lastInsertID, err
 	}
 	return result.LastInsertId()
 }
@@ -126,6 +420,12 @@ func (d *{{ .TableUpperCamelIdent }}Dao) InsertMany(ctx context.Context, valueLi
 		if len(values) == 0 {
 			return errors.New("param values cannot be empty")
 		}
+		if err = checkColumnPolicies({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}ColumnPolicies, values, false); err != nil {
+			return
+		}
+		if err = validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, values, true); err != nil {
+			return
+		}
 		vals := make([]any, 0, len(values))
 		for _, field := range {{ .TableLowerCamelIdent }}Fields {
 			if val, ok := values[field]; ok {
@@ -154,39 +454,71 @@ func (d *{{ .TableUpperCamelIdent }}Dao) InsertMany(ctx context.Context, valueLi
         hasAddUpdate = true
     }
     {{- end }}
-	ib := sqlbuilder.NewInsertBuilder()
-	ib.InsertInto({{ .TableUpperCamelIdent }}TableName)
-	ib.Cols(cols...)
     {{- if or (ne .TimeFields.CreateTime "")  (ne .TimeFields.UpdateTime "")}}
 	curTime := time.Now()
     {{- end }}
-	for _, vals := range valsList {
-        {{- if ne .TimeFields.CreateTime ""}}
-        if hasAddCreate {
-            {{- if eq .TimeFields.CreateType "int" }}
-            vals = append(vals, curTime.Unix())
-            {{- else }}
-            vals = append(vals, curTime)
-            {{- end }}
+    {{- if .Shard }}
+    // Records are grouped by shard, and inserted by one statement per shard
+    type shardRecords struct {
+        conn     *sql.DB
+        table    string
+        valsList [][]any
+    }
+    var shards []*shardRecords
+    for index, vals := range valsList {
+        key, ok := valueList[index][{{ .TableUpperCamelIdent }}ShardKey]
+        if !ok {
+            return ErrShardKeyRequired
         }
-        {{- end }}
-        {{- if ne .TimeFields.UpdateTime ""}}
-        if hasAddUpdate {
-            {{- if eq .TimeFields.UpdateType "int" }}
-            vals = append(vals, curTime.Unix())
-            {{- else }}
-            vals = append(vals, curTime)
-            {{- end }}
+        conn, table, err := d.shard(ctx, key, false)
+        if err != nil {
+            return err
         }
-        {{- end }}
+        var records *shardRecords
+        for _, item := range shards {
+            if item.conn == conn && item.table == table {
+                records = item
+                break
+            }
+        }
+        if records == nil {
+            records = &shardRecords{conn: conn, table: table}
+            shards = append(shards, records)
+        }
+        records.valsList = append(records.valsList, vals)
+    }
+    for _, records := range shards {
+        ib := sqlbuilder.NewInsertBuilder()
+        ib.InsertInto(records.table)
+        ib.Cols(cols...)
+        for _, vals := range records.valsList {
+            {{- template "timeValues" . }}
+            ib.Values(vals...)
+        }
+        sql, args := ib.Build()
+        markWritten(ctx)
+        _, err = d.exec(ctx, records.conn, "InsertMany", d.retryPolicy.IdempotentInserts, sql, args)
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+    {{- else }}
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto({{ .TableUpperCamelIdent }}TableName)
+	ib.Cols(cols...)
+	for _, vals := range valsList {
+        {{- template "timeValues" . }}
 		ib.Values(vals...)
 	}
 	sql, args := ib.Build()
-	_, err = d.db.Exec(sql, args...)
+	markWritten(ctx)
+	_, err = d.exec(ctx, d.db, "InsertMany", d.retryPolicy.IdempotentInserts, sql, args)
 	if err != nil {
 		return err
 	}
 	return nil
+    {{- end }}
 }
 
 
@@ -194,8 +526,18 @@ func (d *{{ .TableUpperCamelIdent }}Dao) InsertMany(ctx context.Context, valueLi
 func (d *{{ .TableUpperCamelIdent }}Dao) Get(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) ({{ .TableLowerCamelIdent }}Entity *{{ .TableUpperCamelIdent }}Entity, err error) {
 	sb := sqlbuilder.NewSelectBuilder()
 	sb.Select({{ .TableLowerCamelIdent }}Fields...)
+    {{- if not .Shard }}
 	sb.From({{ .TableUpperCamelIdent }}TableName)
+    {{- end }}
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- if .Shard }}
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, true)
+    if err != nil {
+        return
+    }
+	sb.From(table)
+    {{- end }}
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&sb.Cond, &o)
 	sb.Where(sqlArgs...)
     sb.OrderBy("{{.Primary}}").Desc()
@@ -204,7 +546,18 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Get(ctx context.Context, conds ...{{ .T
     if d.forceMaster {
         sql = ForceMasterIdentity + sql
     }
-	rows, err := d.db.Query(sql, args...)
+	ctx, end, err := d.start(ctx, "Get", sql, args)
+	if err != nil {
+		return
+	}
+	defer func() {
+		var n int64
+		if {{ .TableLowerCamelIdent }}Entity != nil {
+			n = 1
+		}
+		err = end(n, err)
+	}()
+	rows, err := execer(ctx, {{ template "reader" . }}).QueryContext(ctx, sql, args...)
 	if err != nil {
 		return
 	}
@@ -220,21 +573,49 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Get(ctx context.Context, conds ...{{ .T
 	return
 }
 
+// First retrieves one data record that meets the query criteria like Get, but returns ErrNotFound if there is none.
+func (d *{{ .TableUpperCamelIdent }}Dao) First(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (*{{ .TableUpperCamelIdent }}Entity, error) {
+	{{ .TableLowerCamelIdent }}Entity, err := d.Get(ctx, conds...)
+	if err != nil {
+		return nil, err
+	}
+	if {{ .TableLowerCamelIdent }}Entity == nil {
+		return nil, &Error{Table: {{ .TableUpperCamelIdent }}TableName, Operation: "First", Kind: ErrNotFound}
+	}
+	return {{ .TableLowerCamelIdent }}Entity, nil
+}
+
 // Count returns the total number of data records that meet the query criteria.
 func (d *{{ .TableUpperCamelIdent }}Dao) Count(ctx context.Context, conds ...{{ .TableUpperCamelIdent }}Cond) (total int, err error) {
 	sb := sqlbuilder.NewSelectBuilder()
 	sb.Select("count(*)")
+    {{- if not .Shard }}
 	sb.From({{ .TableUpperCamelIdent }}TableName)
+    {{- end }}
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- if .Shard }}
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, true)
+    if err != nil {
+        return
+    }
+	sb.From(table)
+    {{- end }}
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&sb.Cond, &o)
 	sb.Where(sqlArgs...)
 	sql, args := sb.Build()
     if d.forceMaster {
         sql = ForceMasterIdentity + sql
     }
-	rows, err := d.db.Query(sql, args...)
+	ctx, end, err := d.start(ctx, "Count", sql, args)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = end(1, err)
+	}()
+	rows, err := execer(ctx, {{ template "reader" . }}).QueryContext(ctx, sql, args...)
 	if err != nil {
-        // TODO: log error
 		return
 	}
 	defer rows.Close()
@@ -251,8 +632,18 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Count(ctx context.Context, conds ...{{
 func (d *{{ .TableUpperCamelIdent }}Dao) List(ctx context.Context, limit, offset int, conds ...{{ .TableUpperCamelIdent }}Cond) ({{ .TableLowerCamelIdent }}List []*{{ .TableUpperCamelIdent }}Entity, err error) {
 	sb := sqlbuilder.NewSelectBuilder()
 	sb.Select({{ .TableLowerCamelIdent }}Fields...)
+    {{- if not .Shard }}
 	sb.From({{ .TableUpperCamelIdent }}TableName)
+    {{- end }}
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- if .Shard }}
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, true)
+    if err != nil {
+        return
+    }
+	sb.From(table)
+    {{- end }}
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&sb.Cond, &o)
 	sb.Where(sqlArgs...)
     sb.OrderBy("{{.Primary}}").Desc()
@@ -268,9 +659,15 @@ func (d *{{ .TableUpperCamelIdent }}Dao) List(ctx context.Context, limit, offset
     if d.forceMaster {
         sql = ForceMasterIdentity + sql
     }
-	rows, err := d.db.Query(sql, args...)
+	ctx, end, err := d.start(ctx, "List", sql, args)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = end(int64(len({{ .TableLowerCamelIdent }}List)), err)
+	}()
+	rows, err := execer(ctx, {{ template "reader" . }}).QueryContext(ctx, sql, args...)
 	if err != nil {
-        // TODO: log error
 		return
 	}
 	defer rows.Close()
@@ -290,8 +687,18 @@ func (d *{{ .TableUpperCamelIdent }}Dao) List(ctx context.Context, limit, offset
 func (d *{{ .TableUpperCamelIdent }}Dao) All(ctx context.Context, limit int, conds ...{{ .TableUpperCamelIdent }}Cond) ({{ .TableLowerCamelIdent }}List []*{{ .TableUpperCamelIdent }}Entity, err error) {
 	sb := sqlbuilder.NewSelectBuilder()
 	sb.Select({{ .TableLowerCamelIdent }}Fields...)
+    {{- if not .Shard }}
 	sb.From({{ .TableUpperCamelIdent }}TableName)
+    {{- end }}
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- if .Shard }}
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, true)
+    if err != nil {
+        return
+    }
+	sb.From(table)
+    {{- end }}
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&sb.Cond, &o)
 	sb.Where(sqlArgs...)
     sb.OrderBy("{{.Primary}}").Desc()
@@ -302,9 +709,15 @@ func (d *{{ .TableUpperCamelIdent }}Dao) All(ctx context.Context, limit int, con
     if d.forceMaster {
         sql = ForceMasterIdentity + sql
     }
-	rows, err := d.db.Query(sql, args...)
+	ctx, end, err := d.start(ctx, "All", sql, args)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = end(int64(len({{ .TableLowerCamelIdent }}List)), err)
+	}()
+	rows, err := execer(ctx, {{ template "reader" . }}).QueryContext(ctx, sql, args...)
 	if err != nil {
-        // TODO: log error
 		return
 	}
 	defer rows.Close()
@@ -325,8 +738,25 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Update(ctx context.Context, values map[
 	if len(conds) == 0 {
 		return
 	}
+	if err = checkColumnPolicies({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}ColumnPolicies, values, true); err != nil {
+		return
+	}
+	if err = validateValues({{ .TableUpperCamelIdent }}TableName, {{ .TableLowerCamelIdent }}Constraints, values, false); err != nil {
+		return
+	}
+    {{- if .Shard }}
+    if _, ok := values[{{ .TableUpperCamelIdent }}ShardKey]; ok {
+        return total, ErrShardKeyUpdated
+    }
+	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, false)
+    if err != nil {
+        return
+    }
+    {{- end }}
 	ub := sqlbuilder.NewUpdateBuilder()
-	ub.Update({{ .TableUpperCamelIdent }}TableName)
+	ub.Update({{ template "table" . }})
 	fieldList := make([]string, 0, len(values))
 	for index, val := range values {
 		fieldList = append(fieldList, ub.Assign(index, val))
@@ -343,13 +773,15 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Update(ctx context.Context, values map[
         {{- end }}
     {{- end }}
 	ub.Set(fieldList...)
+    {{- if not .Shard }}
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- end }}
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&ub.Cond, &o)
 	ub.Where(sqlArgs...)
 	sql, args := ub.Build()
-	result, err := d.db.Exec(sql, args...)
+	markWritten(ctx)
+	result, err := d.exec(ctx, {{ template "writer" . }}, "Update", d.retryPolicy.IdempotentUpdates, sql, args)
 	if err != nil {
-        // TODO: log error
 		return
 	}
 	return result.RowsAffected()
@@ -360,26 +792,157 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Delete(ctx context.Context, conds ...{{
 	if len(conds) == 0 {
 		return
 	}
-	db := sqlbuilder.NewDeleteBuilder()
-	db.DeleteFrom({{ .TableUpperCamelIdent }}TableName)
 	o := New{{ .TableUpperCamelIdent }}Conds(conds...)
+    {{- if .Shard }}
+    {{- template "shardByConds" . }}
+    conn, table, err := d.shard(ctx, *o.{{ .Shard.KeyName }}, false)
+    if err != nil {
+        return
+    }
+    {{- end }}
+	db := sqlbuilder.NewDeleteBuilder()
+	db.DeleteFrom({{ template "table" . }})
 	sqlArgs := Build{{ .TableUpperCamelIdent }}Conds(&db.Cond, &o)
 	db.Where(sqlArgs...)
 	sql, args := db.Build()
-	result, err := d.db.Exec(sql, args...)
+	markWritten(ctx)
+	result, err := d.exec(ctx, {{ template "writer" . }}, "Delete", true, sql, args)
 	if err != nil {
-        // TODO: log error
 		return
 	}
 	return result.RowsAffected()
 }
 
-// Query executes a custom query and returns the records that meet the criteria.
+{{- range .References }}
+// {{ .GetName }} retrieves the {{ .Parent.Table }} record referenced by the {{ .Column }} of the record,
+// or nil if {{ .Column }} is NULL or there is none.
+func (d *{{ $.TableUpperCamelIdent }}Dao) {{ .GetName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity) (*{{ .Parent.TableUpperCamelIdent }}Entity, error) {
+    {{- if .IsNull }}
+    if {{ .IsNull }} {
+        return nil, nil
+    }
+    {{- end }}
+    {{- template "relationDao" .Parent }}
+    return {{ .Parent.TableLowerCamelIdent }}Dao.Get(ctx, {{ .RefCond }}({{ .Key }}))
+}
+
+// {{ .LoadName }} retrieves the {{ .Parent.Table }} records referenced by the {{ .Column }} of the records
+// with IN queries of at most Max{{ .Parent.TableUpperCamelIdent }}Limit values, mapped by their {{ .RefColumn }}.
+// The records whose {{ .Column }} is NULL are skipped.
+func (d *{{ $.TableUpperCamelIdent }}Dao) {{ .LoadName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}List []*{{ $.TableUpperCamelIdent }}Entity) (map[{{ .KeyType }}]*{{ .Parent.TableUpperCamelIdent }}Entity, error) {
+    keys := make(map[{{ .KeyType }}]struct{}, len({{ $.TableLowerCamelIdent }}List))
+    values := make([]any, 0, len({{ $.TableLowerCamelIdent }}List))
+    for _, {{ $.TableLowerCamelIdent }}Entity := range {{ $.TableLowerCamelIdent }}List {
+        if {{ $.TableLowerCamelIdent }}Entity == nil{{ if .IsNull }} || {{ .IsNull }}{{ end }} {
+            continue
+        }
+        key := {{ .Key }}
+        if _, ok := keys[key]; !ok {
+            keys[key] = struct{}{}
+            values = append(values, key)
+        }
+    }
+    records := make(map[{{ .KeyType }}]*{{ .Parent.TableUpperCamelIdent }}Entity, len(values))
+    if len(values) == 0 {
+        return records, nil
+    }
+    {{- template "relationDao" .Parent }}
+    list, err := {{ .Parent.TableLowerCamelIdent }}Dao.listIn(ctx, "{{ .LoadName }}", "{{ .RefColumn }}", values)
+    if err != nil {
+        return nil, err
+    }
+    for _, {{ .Parent.TableLowerCamelIdent }}Entity := range list {
+        records[{{ .RefKey }}] = {{ .Parent.TableLowerCamelIdent }}Entity
+    }
+    return records, nil
+}
+
+{{ end }}
+{{- range .ReferencedBy }}
+// {{ .ListName }} retrieves the {{ .Child.Table }} records referencing the record by their {{ .Column }},
+// which meet the query criteria like List of {{ .Child.TableUpperCamelIdent }}Dao.
+func (d *{{ $.TableUpperCamelIdent }}Dao) {{ .ListName }}(ctx context.Context, {{ $.TableLowerCamelIdent }}Entity *{{ $.TableUpperCamelIdent }}Entity, limit, offset int, conds ...{{ .Child.TableUpperCamelIdent }}Cond) ([]*{{ .Child.TableUpperCamelIdent }}Entity, error) {
+    value := {{ .Value }}
+    {{- template "relationDao" .Child }}
+    return {{ .Child.TableLowerCamelIdent }}Dao.List(ctx, limit, offset, append([]{{ .Child.TableUpperCamelIdent }}Cond{ {{- .Cond }}({{ .CondValue }})}, conds...)...)
+}
+
+{{ end }}
+{{- if .ReferencedBy }}
+// listIn retrieves the records whose column is one of the values, for the loaders of the records referenced
+// by other tables. The values are queried in batches of Max{{ .TableUpperCamelIdent }}Limit to keep the IN lists
+// within the placeholder and packet limits.
+func (d *{{ .TableUpperCamelIdent }}Dao) listIn(ctx context.Context, operation, column string, values []any) ({{ .TableLowerCamelIdent }}List []*{{ .TableUpperCamelIdent }}Entity, err error) {
+	for len(values) > 0 {
+		batch := values[:min(len(values), Max{{ .TableUpperCamelIdent }}Limit)]
+		values = values[len(batch):]
+		list, err := d.listBatch(ctx, operation, column, batch)
+		if err != nil {
+			return nil, err
+		}
+		{{ .TableLowerCamelIdent }}List = append({{ .TableLowerCamelIdent }}List, list...)
+	}
+	return
+}
+
+// listBatch retrieves the records whose column is one of the values with a single IN query.
+func (d *{{ .TableUpperCamelIdent }}Dao) listBatch(ctx context.Context, operation, column string, values []any) ({{ .TableLowerCamelIdent }}List []*{{ .TableUpperCamelIdent }}Entity, err error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select({{ .TableLowerCamelIdent }}Fields...)
+	sb.From({{ .TableUpperCamelIdent }}TableName)
+	sb.Where(sb.In(column, values...))
+	sql, args := sb.Build()
+    if d.forceMaster {
+        sql = ForceMasterIdentity + sql
+    }
+	ctx, end, err := d.start(ctx, operation, sql, args)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = end(int64(len({{ .TableLowerCamelIdent }}List)), err)
+	}()
+	rows, err := execer(ctx, d.reader(ctx)).QueryContext(ctx, sql, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	{{ .TableLowerCamelIdent }}Struct := sqlbuilder.NewStruct(new({{ .TableUpperCamelIdent }}Entity))
+	for rows.Next() {
+		{{ .TableLowerCamelIdent }}Entity := &{{ .TableUpperCamelIdent }}Entity{}
+		err = rows.Scan({{ .TableLowerCamelIdent }}Struct.Addr({{ .TableLowerCamelIdent }}Entity)...)
+        if err != nil {
+            return
+        }
+		{{ .TableLowerCamelIdent }}List = append({{ .TableLowerCamelIdent }}List, {{ .TableLowerCamelIdent }}Entity)
+	}
+	err = rows.Err()
+	return
+}
+
+{{ end }}
+// Query executes a custom query on the primary and returns the records that meet the criteria,
+// as the query may write, e.g. SELECT ... FOR UPDATE. Use QueryReplica to read from a replica.
 func (d *{{ .TableUpperCamelIdent }}Dao) Query(query string, args ...any) (*sql.Rows, error) {
+    return d.query(d.db, "Query", query, args)
+}
+
+// QueryReplica executes a custom read-only query and returns the records that meet the criteria.
+// It is sent to a replica like Get and List, unless the DAO is ForceMaster.
+func (d *{{ .TableUpperCamelIdent }}Dao) QueryReplica(query string, args ...any) (*sql.Rows, error) {
+    return d.query(d.reader(context.Background()), "QueryReplica", query, args)
+}
+
+func (d *{{ .TableUpperCamelIdent }}Dao) query(conn *sql.DB, operation, query string, args []any) (*sql.Rows, error) {
     if d.forceMaster {
         query = ForceMasterIdentity + query
     }
-    return d.db.Query(query, args...)
+    ctx, end, err := d.start(context.Background(), operation, query, args)
+    if err != nil {
+        return nil, err
+    }
+    rows, err := execer(ctx, conn).QueryContext(ctx, query, args...)
+    return rows, end(0, err)
 }
 
 // Exec executes a custom SQL statement.
@@ -387,11 +950,96 @@ func (d *{{ .TableUpperCamelIdent }}Dao) Exec(query string, args ...any) (sql.Re
     if d.forceMaster {
         query = ForceMasterIdentity + query
     }
-    return d.db.Exec(query, args...)
+    return d.exec(context.Background(), d.db, "Exec", false, query, args)
+}
+
+
+// reader returns the connection for read operations, a replica unless the primary is required.
+func (d *{{ .TableUpperCamelIdent }}Dao) reader(ctx context.Context) *sql.DB {
+    if d.forceMaster || d.cluster == nil || usePrimary(ctx) {
+        return d.db
+    }
+    return d.cluster.reader()
+}
+
+{{- if .Shard }}
+
+// shard returns the connection and the physical table of the shard of the shard key value.
+func (d *{{ .TableUpperCamelIdent }}Dao) shard(ctx context.Context, key any, read bool) (conn *sql.DB, table string, err error) {
+    index, err := {{ .TableLowerCamelIdent }}ShardResolver.Resolve(key, len({{ .TableLowerCamelIdent }}Shards))
+    if err != nil {
+        return
+    }
+    if index < 0 || index >= len({{ .TableLowerCamelIdent }}Shards) {
+        return nil, "", fmt.Errorf("shard index %d of key %v is out of range", index, key)
+    }
+    s := {{ .TableLowerCamelIdent }}Shards[index]
+    conn = d.db
+    if d.cluster != nil {
+        c, err := getCluster(s.Database)
+        if err != nil {
+            return nil, "", fmt.Errorf("shard %s: %w", s.Table, err)
+        }
+        conn = c.primary
+        if read && !d.forceMaster && !usePrimary(ctx) {
+            conn = c.reader()
+        }
+    }
+    return conn, s.Table, nil
+}
+{{- end }}
+
+// start invokes BeforeQuery of the hooks, and returns the context of the operation and the function
+// mapping the error of the operation and invoking AfterQuery with the number of rows and the mapped error.
+// It returns the error of New{{ .TableUpperCamelIdent }}Dao if the database of the table has no connection.
+func (d *{{ .TableUpperCamelIdent }}Dao) start(ctx context.Context, operation, query string, args []any) (context.Context, func(rows int64, err error) error, error) {
+    if d.err != nil {
+        return ctx, nil, d.err
+    }
+    ctx, end := startQuery(ctx, d.hooks, {{ .TableUpperCamelIdent }}Database, {{ .TableUpperCamelIdent }}TableName, operation, query, args)
+    return ctx, func(rows int64, err error) error {
+        err = mapError({{ .TableUpperCamelIdent }}TableName, operation, {{ .TableLowerCamelIdent }}UniqueIndexes, err)
+        end(rows, err)
+        return err
+    }, nil
+}
+
+// exec executes a write statement on db, or in the transaction of ctx on db, and retries it by the
+// retry policy of the current object outside of transactions.
+func (d *{{ .TableUpperCamelIdent }}Dao) exec(ctx context.Context, db *sql.DB, operation string, idempotent bool, query string, args []any) (result sql.Result, err error) {
+    conn := execer(ctx, db)
+    policy := d.retryPolicy
+    if _, ok := conn.(*sql.Tx); ok {
+        policy = RetryPolicy{}
+    }
+    err = policy.do(ctx, func(err error) bool {
+        return isRetryable(err, idempotent)
+    }, func() error {
+        ctx, end, err := d.start(ctx, operation, query, args)
+        if err != nil {
+            return err
+        }
+        var rows int64
+        result, err = conn.ExecContext(ctx, query, args...)
+        if err == nil {
+            rows, _ = result.RowsAffected()
+        }
+        return end(rows, err)
+    })
+    return
 }
 
+// SetRetryPolicy sets the retry policy of write operations of the current object, DefaultRetryPolicy by default.
+func (d *{{ .TableUpperCamelIdent }}Dao) SetRetryPolicy(policy RetryPolicy) {
+    d.retryPolicy = policy
+}
+
+// AddHook adds hooks invoked by the operations of the current object, after the global hooks.
+func (d *{{ .TableUpperCamelIdent }}Dao) AddHook(hooks ...Hook) {
+    d.hooks = append(d.hooks, hooks...)
+}
 
-// ForceMaster adds the master identity for operations of the current object. 
+// ForceMaster adds the master identity and sends all operations of the current object to the primary. 
 func (d *{{ .TableUpperCamelIdent }}Dao) ForceMaster(){
     d.forceMaster = true
 }
@@ -401,10 +1049,54 @@ func (d *{{ .TableUpperCamelIdent }}Dao) DisableForceMaster(){
     d.forceMaster = false
 }
 
+// UseConn uses db for all operations of the current object, without read/write splitting.
 func (d *{{ .TableUpperCamelIdent }}Dao) UseConn(db *sql.DB){
     d.db = db
+    d.cluster = nil
+    d.err = nil
 }
 
 func (d *{{ .TableUpperCamelIdent }}Dao) CloneConn() (db *sql.DB) {
     return d.db
 }
+
+{{- define "table" }}{{ if .Shard }}table{{ else }}{{ .TableUpperCamelIdent }}TableName{{ end }}{{ end }}
+
+{{- define "relationDao" }}
+    {{ .TableLowerCamelIdent }}Dao := New{{ .TableUpperCamelIdent }}Dao()
+    if d.forceMaster {
+        {{ .TableLowerCamelIdent }}Dao.ForceMaster()
+    }
+{{- end }}
+
+{{- define "reader" }}{{ if .Shard }}conn{{ else }}d.reader(ctx){{ end }}{{ end }}
+
+{{- define "writer" }}{{ if .Shard }}conn{{ else }}d.db{{ end }}{{ end }}
+
+{{- define "shardByConds" }}
+    if o.{{ .Shard.KeyName }} == nil {
+        err = ErrShardKeyRequired
+        return
+    }
+{{- end }}
+
+{{- define "timeValues" }}
+        {{- if ne .TimeFields.CreateTime ""}}
+        if hasAddCreate {
+            {{- if eq .TimeFields.CreateType "int" }}
+            vals = append(vals, curTime.Unix())
+            {{- else }}
+            vals = append(vals, curTime)
+            {{- end }}
+        }
+        {{- end }}
+        {{- if ne .TimeFields.UpdateTime ""}}
+        if hasAddUpdate {
+            {{- if eq .TimeFields.UpdateType "int" }}
+            vals = append(vals, curTime.Unix())
+            {{- else }}
+            vals = append(vals, curTime)
+            {{- end }}
+        }
+        {{- end }}
+{{- end }}
@@ -0,0 +1,143 @@
+// This file was generated by go-dao-code-gen
+
+package {{ .Pkg }}
+
+import (
+	"context"
+	{{- if .Shard }}
+	"errors"
+	{{- end }}
+	"testing"
+)
+
+// {{ .TableLowerCamelIdent }}Fixture returns the values of the n-th fixture row of the {{ .Table }} table.
+func {{ .TableLowerCamelIdent }}Fixture(n int) map[string]any {
+	return map[string]any{
+		{{- range .Test.Fixtures }}
+		"{{ .Column }}": {{ .Value }},
+		{{- end }}
+	}
+}
+
+// {{ .TableLowerCamelIdent }}FixtureConds returns the conditions identifying the n-th fixture row.
+func {{ .TableLowerCamelIdent }}FixtureConds(n int) []{{ .TableUpperCamelIdent }}Cond {
+	return []{{ .TableUpperCamelIdent }}Cond{
+		{{- range .Test.Conds }}
+		Set{{ $.TableUpperCamelIdent }}{{ .Name }}({{ .Cond }}),
+		{{- end }}
+	}
+}
+
+func Test{{ .TableUpperCamelIdent }}Dao(t *testing.T) {
+	requireTestDB(t)
+	ctx := context.Background()
+	d := New{{ .TableUpperCamelIdent }}Dao()
+	t.Cleanup(func() {
+		for n := range 3 {
+			if _, err := d.Delete(ctx, {{ .TableLowerCamelIdent }}FixtureConds(n)...); err != nil {
+				t.Errorf("delete fixture row %d failed, %v", n, err)
+			}
+		}
+	})
+
+	if _, err := d.Insert(ctx, {{ .TableLowerCamelIdent }}Fixture(0)); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+	if err := d.InsertMany(ctx, []map[string]any{ {{- .TableLowerCamelIdent }}Fixture(1), {{ .TableLowerCamelIdent }}Fixture(2)}); err != nil {
+		t.Fatalf("InsertMany() error = %v", err)
+	}
+	for n := range 3 {
+		conds := {{ .TableLowerCamelIdent }}FixtureConds(n)
+		entity, err := d.Get(ctx, conds...)
+		if err != nil {
+			t.Fatalf("Get(fixture %d) error = %v", n, err)
+		}
+		if entity == nil {
+			t.Fatalf("Get(fixture %d) = nil, want the fixture row", n)
+		}
+		total, err := d.Count(ctx, conds...)
+		if err != nil {
+			t.Fatalf("Count(fixture %d) error = %v", n, err)
+		}
+		if total != 1 {
+			t.Errorf("Count(fixture %d) = %d, want 1", n, total)
+		}
+		list, err := d.List(ctx, 10, 0, conds...)
+		if err != nil {
+			t.Fatalf("List(fixture %d) error = %v", n, err)
+		}
+		if len(list) != 1 {
+			t.Errorf("List(fixture %d) returns %d rows, want 1", n, len(list))
+		}
+	}
+
+	conds := {{ .TableLowerCamelIdent }}FixtureConds(0)
+	{{- if .Test.Update }}
+	values := map[string]any{"{{ .Test.Update.Column }}": {{ .TableLowerCamelIdent }}Fixture({{ .Test.UpdateRow }})["{{ .Test.Update.Column }}"]}
+	total, err := d.Update(ctx, values, conds...)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Update() = %d, want 1", total)
+	}
+	total, err = d.Delete(ctx, conds...)
+	{{- else }}
+	total, err := d.Delete(ctx, conds...)
+	{{- end }}
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Delete() = %d, want 1", total)
+	}
+	entity, err := d.Get(ctx, conds...)
+	if err != nil {
+		t.Fatalf("Get(deleted fixture) error = %v", err)
+	}
+	if entity != nil {
+		t.Errorf("Get(deleted fixture) = %+v, want nil", entity)
+	}
+}
+{{- if .Shard }}
+
+// Test{{ .TableUpperCamelIdent }}FakeShardKey tests that the fake repository requires the shard key like {{ .TableUpperCamelIdent }}Dao.
+func Test{{ .TableUpperCamelIdent }}FakeShardKey(t *testing.T) {
+	ctx := context.Background()
+	entity := &{{ .TableUpperCamelIdent }}Entity{}
+	f := NewFake{{ .TableUpperCamelIdent }}Repository(entity)
+	values := {{ .TableLowerCamelIdent }}Fixture(0)
+	delete(values, {{ .TableUpperCamelIdent }}ShardKey)
+	if _, err := f.Insert(ctx, values); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("Insert() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	noKey := func(*{{ .TableUpperCamelIdent }}Conds) {}
+	if _, err := f.Get(ctx, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("Get() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	if _, err := f.Count(ctx, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("Count() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	if _, err := f.List(ctx, 10, 0, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("List() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	if _, err := f.All(ctx, 0, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("All() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	{{- if .Test.Update }}
+	update := map[string]any{"{{ .Test.Update.Column }}": {{ .TableLowerCamelIdent }}Fixture({{ .Test.UpdateRow }})["{{ .Test.Update.Column }}"]}
+	if _, err := f.Update(ctx, update, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("Update() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	{{- end }}
+	if _, err := f.Delete(ctx, noKey); !errors.Is(err, ErrShardKeyRequired) {
+		t.Errorf("Delete() without the shard key error = %v, want ErrShardKeyRequired", err)
+	}
+	withKey := func(o *{{ .TableUpperCamelIdent }}Conds) {
+		o.{{ .Shard.KeyName }} = &entity.{{ .Shard.KeyName }}
+	}
+	if total, err := f.Count(ctx, withKey); err != nil || total != 1 {
+		t.Errorf("Count() with the shard key = %d, %v, want 1", total, err)
+	}
+}
+{{- end }}
@@ -0,0 +1,66 @@
+// This file was generated by go-dao-code-gen
+
+package {{ .Pkg }}
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName specifies the name of the tracer of DAO spans.
+const TracerName = "go-dao-code-gen/{{ .Pkg }}"
+
+// tracerProvider creates the tracer of DAO spans, the global provider of otel if nil.
+var tracerProvider trace.TracerProvider
+
+func init() {
+    AddHook(tracingHook{})
+}
+
+// SetTracerProvider sets the provider of the tracer of DAO spans, the global provider of otel by default.
+// Call it before DAOs are used, e.g. with a provider of an in-memory exporter in tests.
+func SetTracerProvider(tp trace.TracerProvider) {
+    tracerProvider = tp
+}
+
+type spanCtxKey struct{}
+
+// tracingHook starts a span named dao.<table>.<operation> for every DAO operation.
+type tracingHook struct{}
+
+// BeforeQuery implements Hook.
+func (tracingHook) BeforeQuery(ctx context.Context, info *QueryInfo) context.Context {
+    tp := tracerProvider
+    if tp == nil {
+        tp = otel.GetTracerProvider()
+    }
+    ctx, span := tp.Tracer(TracerName).Start(ctx, "dao."+info.Table+"."+info.Operation,
+        trace.WithSpanKind(trace.SpanKindClient),
+        trace.WithAttributes(
+            attribute.String("db.system", "mysql"),
+            attribute.String("db.name", info.Database),
+            attribute.String("db.sql.table", info.Table),
+            attribute.String("db.operation", info.Operation),
+            attribute.String("db.statement", info.SQL),
+        ),
+    )
+    return context.WithValue(ctx, spanCtxKey{}, span)
+}
+
+// AfterQuery implements Hook.
+func (tracingHook) AfterQuery(ctx context.Context, info *QueryInfo) {
+    span, ok := ctx.Value(spanCtxKey{}).(trace.Span)
+    if !ok {
+        return
+    }
+    span.SetAttributes(attribute.Int64("db.rows_affected", info.Rows))
+    if info.Err != nil {
+        span.RecordError(info.Err)
+        span.SetStatus(codes.Error, info.Err.Error())
+    }
+    span.End()
+}
@@ -0,0 +1,249 @@
+// This file was generated by go-dao-code-gen. 
+// You can modify it to be more suitable. 
+
+package {{ .Pkg }}
+
+{{- if or .Types.JSON .Types.Geometry .Types.Duration .Types.Bits }}
+
+import (
+	"database/sql/driver"
+	"fmt"
+	{{- if .Types.Geometry }}
+	"encoding/binary"
+	{{- end }}
+	{{- if .Types.JSON }}
+	"encoding/json"
+	{{- end }}
+	{{- if .Types.Duration }}
+	"strconv"
+	"strings"
+	"time"
+	{{- end }}
+)
+{{- end }}
+
+{{- if .Types.JSON }}
+
+// JSON wraps a value of type T stored in a JSON column.
+// It implements sql.Scanner and driver.Valuer, and is marshaled as T itself.
+type JSON[T any] struct {
+	V T
+}
+
+// NewJSON returns a JSON wrapper of v.
+func NewJSON[T any](v T) JSON[T] {
+	return JSON[T]{V: v}
+}
+
+// Scan implements the sql.Scanner interface.
+func (j *JSON[T]) Scan(src any) error {
+	var zero T
+	j.V = zero
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, &j.V)
+	case string:
+		return json.Unmarshal([]byte(v), &j.V)
+	}
+	return fmt.Errorf("cannot scan %T into JSON", src)
+}
+
+// Value implements the driver.Valuer interface.
+// The document is returned as string, MySQL rejects JSON values with the binary character set.
+func (j JSON[T]) Value() (driver.Value, error) {
+	b, err := json.Marshal(j.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSON[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (j *JSON[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &j.V)
+}
+{{- end }}
+
+{{- if .Types.JSONCond }}
+
+// JSONCond specifies a condition on a JSON column.
+type JSONCond struct {
+	Path     string // JSON path, e.g. "$.address.city"
+	Contains bool   // JSON_CONTAINS(column, Value, Path) if true, otherwise column->>Path = Value
+	Value    any    // JSON document if Contains is true, otherwise the value of the unquoted path
+}
+{{- end }}
+
+{{- if .Types.Geometry }}
+
+// Geometry represents a value of a spatial column as well-known binary (WKB).
+// MySQL stores geometries as a 4-byte little-endian SRID followed by the WKB.
+type Geometry struct {
+	SRID uint32
+	WKB  []byte
+}
+
+// Scan implements the sql.Scanner interface.
+func (g *Geometry) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*g = Geometry{}
+		return nil
+	case []byte:
+		if len(v) < 4 {
+			return fmt.Errorf("invalid geometry of %d bytes", len(v))
+		}
+		g.SRID = binary.LittleEndian.Uint32(v)
+		g.WKB = append([]byte(nil), v[4:]...)
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into Geometry", src)
+}
+
+// Value implements the driver.Valuer interface.
+func (g Geometry) Value() (driver.Value, error) {
+	b := make([]byte, 4, 4+len(g.WKB))
+	binary.LittleEndian.PutUint32(b, g.SRID)
+	return append(b, g.WKB...), nil
+}
+{{- end }}
+
+{{- if .Types.Duration }}
+
+// Duration represents a value of a TIME column, which ranges from -838:59:59 to 838:59:59.
+type Duration time.Duration
+
+// Scan implements the sql.Scanner interface.
+func (d *Duration) Scan(src any) (err error) {
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+	case []byte:
+		*d, err = ParseDuration(string(v))
+	case string:
+		*d, err = ParseDuration(v)
+	case time.Duration:
+		*d = Duration(v)
+	default:
+		err = fmt.Errorf("cannot scan %T into Duration", src)
+	}
+	return
+}
+
+// Value implements the driver.Valuer interface.
+func (d Duration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// String returns the duration in the MySQL TIME format, e.g. "-12:30:00.5".
+func (d Duration) String() string {
+	sign := ""
+	v := time.Duration(d)
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	h := v / time.Hour
+	m := v % time.Hour / time.Minute
+	s := v % time.Minute / time.Second
+	us := v % time.Second / time.Microsecond
+	if us == 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d", sign, h, m, s)
+	}
+	return strings.TrimRight(fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, h, m, s, us), "0")
+}
+
+// ParseDuration parses a value in the MySQL TIME format, e.g. "838:59:59" or "-00:00:01.5".
+func ParseDuration(s string) (Duration, error) {
+	var neg bool
+	v := s
+	if strings.HasPrefix(v, "-") {
+		neg, v = true, v[1:]
+	}
+	parts := strings.Split(v, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid TIME value %q", s)
+	}
+	h, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid TIME value %q", s)
+	}
+	m, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid TIME value %q", s)
+	}
+	sec, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid TIME value %q", s)
+	}
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec*float64(time.Second)).Round(time.Microsecond)
+	if neg {
+		d = -d
+	}
+	return Duration(d), nil
+}
+{{- end }}
+
+{{- if .Types.Bits }}
+
+// Bits represents a value of a BIT(n) column with n > 1, bit 0 being the least significant one.
+type Bits uint64
+
+// Has reports whether bit i is set.
+func (b Bits) Has(i uint) bool {
+	return b&(1<<i) != 0
+}
+
+// Set sets bit i.
+func (b *Bits) Set(i uint) {
+	*b |= 1 << i
+}
+
+// Clear clears bit i.
+func (b *Bits) Clear(i uint) {
+	*b &^= 1 << i
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *Bits) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*b = 0
+	case []byte:
+		// The driver returns bit values as big-endian bytes
+		if len(v) > 8 {
+			return fmt.Errorf("invalid bit value of %d bytes", len(v))
+		}
+		var n uint64
+		for _, c := range v {
+			n = n<<8 | uint64(c)
+		}
+		*b = Bits(n)
+	case int64:
+		*b = Bits(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Bits", src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (b Bits) Value() (driver.Value, error) {
+	if b <= 1<<63-1 {
+		return int64(b), nil
+	}
+	// Values above the int64 range are sent as BIT(64) bytes
+	v := make([]byte, 8)
+	for i := 7; i >= 0; i-- {
+		v[i] = byte(b)
+		b >>= 8
+	}
+	return v, nil
+}
+{{- end }}
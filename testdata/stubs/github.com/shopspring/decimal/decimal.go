@@ -0,0 +1,21 @@
+// Package decimal stubs the API of github.com/shopspring/decimal used by the generated code.
+package decimal
+
+import "database/sql/driver"
+
+type Decimal struct{}
+
+func (d *Decimal) Scan(value any) error { return nil }
+
+func (d Decimal) Value() (driver.Value, error) { return nil, nil }
+
+func (d Decimal) Equal(d2 Decimal) bool { return true }
+
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool
+}
+
+func (d *NullDecimal) Scan(value any) error { return nil }
+
+func (d NullDecimal) Value() (driver.Value, error) { return nil, nil }
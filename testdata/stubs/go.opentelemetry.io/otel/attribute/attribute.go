@@ -0,0 +1,14 @@
+// Package attribute stubs the API of go.opentelemetry.io/otel/attribute used by the generated code.
+package attribute
+
+type Key string
+
+type KeyValue struct {
+	Key Key
+}
+
+func String(k, v string) KeyValue { return KeyValue{Key: Key(k)} }
+
+func Int(k string, v int) KeyValue { return KeyValue{Key: Key(k)} }
+
+func Int64(k string, v int64) KeyValue { return KeyValue{Key: Key(k)} }
@@ -0,0 +1,10 @@
+// Package codes stubs the API of go.opentelemetry.io/otel/codes used by the generated code.
+package codes
+
+type Code uint32
+
+const (
+	Unset Code = iota
+	Error
+	Ok
+)
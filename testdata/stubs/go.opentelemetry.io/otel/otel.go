@@ -0,0 +1,6 @@
+// Package otel stubs the API of go.opentelemetry.io/otel used by the generated code.
+package otel
+
+import "go.opentelemetry.io/otel/trace"
+
+func GetTracerProvider() trace.TracerProvider { return nil }
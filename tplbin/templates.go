@@ -2,7 +2,13 @@
 // sources:
 // templates/conds.tpl
 // templates/dao.tpl
+// templates/dao_test.tpl
+// templates/metrics.tpl
+// templates/repository.tpl
 // templates/table.tpl
+// templates/table_test.tpl
+// templates/tracing.tpl
+// templates/types.tpl
 package tplbin
 
 import (
@@ -79,7 +85,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _condsTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x6e\xdb\x3a\x10\x3c\x9b\x5f\x31\xcf\x78\x08\x6c\xc3\xa6\xee\x0f\xf0\xe1\x35\xe8\x21\x97\xa0\x40\xd3\x43\x51\x14\x05\x2d\xad\x64\x22\x12\xa9\x90\x54\x03\x43\xe1\xbf\x17\xa4\xa4\x58\x72\x93\xca\xa8\x4e\xd6\x8a\x9c\xdd\x99\x9d\x5d\x27\x09\x1e\x8e\xd2\x22\x97\x25\xe1\x59\x58\x14\xa4\xc8\x08\x47\x19\x0e\x27\x14\x7a\x97\x09\xbd\x4b\x75\x46\xbb\x82\x14\x07\x4b\x12\x7c\xd5\x0d\x52\xa1\x50\xe9\x4c\xe6\x27\x48\x07\xa7\x71\x20\x54\xda\x10\x6c\x23\x9d\x38\x94\xc4\xc1\x58\x2d\xd2\x47\x51\x10\xda\x16\xfc\xd3\x63\x01\xef\x19\x93\x55\xad\x8d\xc3\x8a\x2d\x96\x85\x74\xc7\xe6\xc0\x53\x5d\x25\xc7\x46\xa8\xac\x49\x0a\xbd\xb3\x4f\xe5\xa1\x91\x65\x46\x66\xc9\x00\xa0\x6d\x77\x30\x42\x15\x04\x7e\x17\xaf\xda\x00\x83\xfe\x59\x06\x68\x78\x7f\x3e\x4b\x2a\x0b\x07\xd6\x2c\x54\x1a\xbe\x3e\x84\x6a\xbe\xd4\x35\x99\x5b\x51\x51\x79\x97\x91\x72\xf0\xfe\x56\xab\xcc\xc2\xd6\x94\xca\x5c\x92\x85\x3b\x12\x52\xad\x32\xe9\xa4\x56\xc8\x25\x95\x99\x85\xce\x63\xbc\x23\xc4\xdc\xa9\xa6\x2b\x20\x9d\x69\x52\x87\x96\x8d\x2a\xff\xdf\x39\x33\xa9\x3b\xa0\xdc\x8b\x8a\xe0\x3d\x36\x11\x32\x60\x7b\x1f\x3e\xc8\x1c\xfc\x56\x57\x55\x07\xda\xb3\x38\x07\x46\x2c\xc1\x46\x8c\xfd\x35\x8c\x2f\x09\x97\xda\x36\x86\x90\x37\x2a\xed\x78\x6b\x73\x56\xc1\x5e\x47\x39\xde\x5e\x6d\xe6\x84\xe9\x3a\x72\x4f\xcf\xb3\x0a\x1a\x72\x8d\x51\x16\x62\x54\x0a\x48\x39\xe9\x4e\xc1\x93\x02\xa5\xb4\x2e\xf4\x66\xd4\xaf\x9e\x80\xe5\x2c\xfc\xbc\x26\xcd\x2a\x8d\xc9\x38\xe7\x33\x27\xd7\xf3\x3d\x6f\xd9\xe2\xa7\x30\xd0\xb3\x27\xd9\x22\x28\xfc\x63\x1b\x4b\xc7\x7f\xfb\xde\x20\xe9\x80\xb2\x08\xbf\x56\x37\x7a\xcd\x16\x9e\x2d\x3a\x21\xa0\x43\x6f\xdf\x72\x53\x92\xe0\x33\xb9\xb6\xc5\xbf\xef\x25\x1d\x7c\x36\x09\x8e\x05\x7e\xcb\x00\xd3\x51\xd0\x2a\x06\xe2\x44\xf4\xea\xfe\x4d\xd2\xd5\x24\xda\x5b\x7d\xb0\x7d\x94\xf8\x5d\xbc\x68\xb2\xf6\x55\x8e\x68\x37\x1d\xc7\xe6\x8f\x57\xec\x3a\x2a\xaa\xf9\x78\xd8\xf6\xb8\xb9\x2c\x24\x48\x1d\x05\x1e\x26\xa9\x23\xf9\x21\x6c\xa1\x59\x13\xd9\xa7\x32\x96\xb7\x39\xef\x2d\x1e\x02\xdb\xbe\xa7\xf3\x63\x81\x95\x30\x85\xc5\xb7\xef\xd6\x19\xa9\x8a\x50\x74\xd8\x10\x32\xc7\x80\xbd\xdf\x43\xc9\x12\x2f\x2f\x3d\x66\xff\xde\xbe\x6e\x93\x4e\x98\xf8\xea\x2f\x97\x66\x34\xcb\x64\xf3\xec\x02\xb6\xa2\x5e\xfc\xa5\x93\x15\xf1\x07\x59\xd1\x32\x6e\x14\x8c\x1e\xd9\x8d\x98\x9d\x28\xf8\xcf\x65\xf6\xe1\x89\x34\xf6\x10\x75\x4d\x2a\x8b\xa4\xb6\x03\x05\xfe\xf1\xa9\x11\xe5\x6a\xd9\x89\x11\xfe\x08\x96\x5b\x6c\x7e\xc3\x5e\xaf\x27\xa0\xd3\xa2\xfb\xe6\x5c\xbc\x0e\xa6\x08\xe9\x98\x67\xbf\x02\x00\x00\xff\xff\x2b\x59\x72\x45\xce\x06\x00\x00"
+var _condsTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\xdf\x6f\xdb\x36\x10\x7e\xb6\xfe\x8a\xaf\x44\x50\x48\xae\x2d\xbf\x07\x70\x81\xcc\xc8\x43\x36\xc0\xed\x16\xb7\xd8\x30\x0c\x05\x23\x51\x36\x53\x99\x54\x44\x2a\x5d\xa0\xea\x7f\x1f\x8e\xfa\x61\xd9\x96\xeb\xa4\x1d\x06\xcc\x7e\x21\x75\xd4\xdd\x77\xdf\xfd\xe0\x69\x36\xc3\x6a\x23\x0d\x12\x99\x0a\x7c\xe1\x06\x6b\xa1\x44\xce\xad\x88\x71\xf7\x84\xb5\x9e\xc6\x5c\x4f\x23\x1d\x8b\xe9\x5a\xa8\x10\xde\x6c\x86\x3f\x74\x81\x88\x2b\x6c\x75\x2c\x93\x27\x48\x0b\xab\x71\x27\xb0\xd5\xb9\x80\x29\xa4\xe5\x77\xa9\x08\xe1\x79\x19\x8f\x3e\xf3\xb5\x40\x59\x22\x7c\xff\x79\x8d\xaa\xf2\x3c\xb9\xcd\x74\x6e\xe1\x7b\x23\xb6\x96\x76\x53\xdc\x85\x91\xde\xce\x36\x05\x57\x71\x31\x5b\xeb\xa9\x79\x48\xef\x0a\x99\xc6\x22\x67\x1e\x00\x94\xe5\x14\x39\x57\x6b\x81\xf0\xc6\xbd\x6a\x48\x0d\x9a\x1f\x23\xd5\xa8\xaa\xdd\x59\xa1\x62\x3a\x10\x78\x84\x94\xa4\x2b\x42\xf3\x21\xcb\x44\xbe\xe0\x5b\x91\xde\xc4\x42\x59\x54\xd5\x42\xab\xd8\xc0\x64\x22\x92\x89\x14\x06\x76\x23\x10\x69\x15\x4b\x2b\xb5\x42\x22\x45\x1a\x1b\xe8\xc4\x3d\xaf\x1d\xf2\xec\x53\x26\x9e\xa1\xd2\xe6\x45\x64\x51\x7a\x3d\xe4\x57\xd6\xe6\x7b\xb8\x49\xcb\x92\x6f\x05\xaa\x0a\x63\xda\xac\x48\x77\x55\x91\x7a\x99\x20\x5c\xe8\xed\xb6\xc6\xd9\x78\xb1\x7b\xd0\xf3\x12\x3d\x7d\x53\xf7\xde\x8d\xf9\xf9\xf6\xdd\xf2\x84\x25\x12\xd5\x6e\xff\xf9\x57\xbb\xde\xd3\xd0\x70\xd7\x5b\x56\xcf\xa1\xf1\x90\xc5\x54\x9b\x22\x17\x48\x0a\x15\xd5\x64\xea\x7c\x47\xad\x79\x1e\x8f\xee\x6d\x7f\x7c\xe6\x94\xa9\xc3\xbc\x14\x5f\xce\x1d\x44\x2e\x6c\x91\x2b\x03\xde\x83\x02\xa1\xac\xb4\x4f\x94\xe8\x1c\xa9\x34\x96\x02\xde\x89\x3b\x07\x4c\xe8\xd1\xf2\x39\x66\x7c\x7a\xdb\x20\x0c\xc3\x33\x27\x83\x73\x04\x18\x94\xde\xe8\x91\xe7\xd0\x67\x4f\x7a\xa3\x44\xe7\xf8\x34\x71\x9e\xe1\x72\xde\x64\x5d\xd4\x6a\x19\xd1\xca\x7f\xad\x03\x6f\x54\x79\xa3\x9a\x08\x68\xaf\xf2\x06\x53\x74\x36\xc3\xad\xb0\x65\x89\x8b\x53\x46\xdb\x94\xda\x7b\xd8\x27\x78\x28\x01\xf6\xeb\x4b\x2b\x97\x2a\xae\xcc\x1a\x76\xbf\xc7\xa8\xbf\xf7\xb4\xa9\x9f\xb6\x96\x02\x7c\x4b\x1f\x31\x87\xb2\xa3\x83\x20\xf8\x1a\xe3\x73\xaf\x98\x80\x5e\x1a\xe9\xb0\xb5\x4c\x46\xe7\x78\x7d\x08\x84\xa8\xae\xbc\xe3\xb2\xfc\x5e\x7a\x17\x5a\x59\x2e\x95\x79\x11\xcd\x64\x8c\x0a\xfd\xd3\xe2\xdd\x72\x75\x75\xb3\xbc\x75\x7c\xad\x38\x75\xe2\x09\x62\x1d\x4d\x90\x71\xbb\x09\xdc\x1a\x92\x94\xd2\x71\xda\x15\xae\xfd\x70\x15\xbb\x13\x88\x45\xc2\x8b\xd4\x1a\xea\xf4\xec\x82\xfd\x40\xc8\x5a\x47\x7c\xd2\x5b\x1b\x36\x36\x97\x6a\xfd\x9f\x85\x6b\xd7\x06\xe7\xe0\x59\x26\x54\xec\x0f\xcb\x27\x68\x97\xe5\x7b\x6e\x37\x97\x8e\x8a\x09\x5a\x0f\x2e\x61\xf3\x42\x4c\xf0\x91\xa7\x85\xb8\x24\x4f\x2a\x57\x61\xdf\x1f\xe4\xeb\xbf\x6d\xce\x23\xfb\xe2\x18\xef\xa2\x3a\x7d\xfb\x96\x40\x62\x8e\x47\x42\x35\x81\x08\xd7\xe1\x8f\x60\xf1\xd9\x45\xa8\xf8\x56\xb0\x09\x58\xa2\x35\x0b\x7e\x20\xf4\xad\x4a\x87\xb0\x0e\xfa\xa4\xc6\x09\xae\x9e\xfe\x37\xf1\x6f\xe2\xed\x80\xb7\x11\xef\x5d\x99\x5e\x59\x76\x4b\x47\xd5\x4f\x34\xce\x9c\xeb\xe1\xbe\x79\x48\xc9\x12\xc6\xbb\x01\x28\xa4\x07\x75\x57\x37\x18\x9f\xd3\x10\xc0\xe7\xf9\x9a\xee\xf6\xae\x9e\xdc\xe5\x2e\x13\xb4\xba\xe7\x73\x28\x99\xe2\xeb\xd7\x46\x67\xb3\x2f\xbb\x21\xa0\x66\xd7\x6d\xab\x6e\xa2\xea\x5f\x10\x7b\x83\x85\x1b\x39\x94\x68\x86\x17\x66\xe5\x56\x84\x2b\xb9\x15\x6c\x6f\x34\x69\x30\x38\x8b\x7d\x9a\xf1\xea\xd0\x7a\x4f\x6b\xb8\x2c\xd2\xf4\x17\x59\xd3\xd8\xca\xda\x9f\x4c\x9a\x31\x49\x3c\xf4\x0e\xb2\x47\x9e\xca\x98\x6c\xbf\x3a\xb2\x15\x7e\x24\x19\x05\x26\x35\x64\x7b\x7c\x8c\xa6\xe6\xa2\x8b\xdd\x01\xac\xf6\xef\x18\xee\xd2\x86\x76\x93\x96\xdd\xf0\xc6\x10\x16\x9f\xed\xaa\x91\x05\xc1\x91\x96\xaa\xc6\xf0\x7c\xf5\x65\x09\x2b\xb6\x59\xca\xad\x00\x13\x0f\x05\x4f\x19\x42\x54\xd5\x80\xea\xbd\x27\xc4\x64\xe3\xae\xf7\xef\xd9\xe9\x25\xfa\xb0\xe9\x81\x03\xc7\xb7\x60\x2b\xa1\x7f\x33\xbb\xdc\x47\x07\x93\xcb\x60\x49\x0e\xf0\xe6\xba\xc9\xe5\x1c\xf7\x51\x48\x5d\xfa\x48\x2e\x13\x57\xb7\x98\xcf\xc1\xd8\x09\xde\x9b\x9e\xc9\x2e\xd8\x91\xb8\x1a\x52\x78\x1f\x85\xed\x2d\xf0\x82\x48\xb2\xd3\x77\x31\xc3\x9b\x2e\x8f\x3e\xf2\xdc\xbf\x8f\x28\x65\x0b\x11\xe0\x0d\xd8\xb1\x98\xf0\x3a\x51\xc0\x5e\x98\x61\xb3\x19\xae\x1f\x0a\xf9\xc8\x53\xea\xab\x56\x1f\xdf\x1d\x13\x7c\xd9\xc8\x68\x83\x58\x0b\x03\xa5\x2d\x78\x14\x89\xcc\x82\x23\x4b\x79\x24\x36\x9a\x5a\x13\xb8\x71\xac\x86\x2f\x74\xfe\xc3\xf2\xd7\x0f\xef\x56\xd7\xbe\xdb\x5c\xff\xbe\xfa\xed\x6a\xb1\xfa\x26\x11\x3b\x4f\x03\xcc\x4f\xd3\x34\xc0\x82\x37\x1c\xc5\x83\xfc\xec\x6d\xdb\xbb\x85\xca\xae\x1d\x8e\x63\x91\x48\x25\x5c\x6f\x29\x5c\x5f\x1b\x6a\x3c\x99\x96\xca\x8a\x9c\xc4\xe3\xe3\xce\xf2\xad\xae\xd3\xb5\x9b\x6e\xb1\x6f\xb6\x29\xc3\x1e\x56\xaa\xa3\x05\x37\x16\xd3\xc3\xaf\xe0\x3a\x88\x98\x63\x71\x75\xbb\xf2\x0f\x99\xda\xab\xee\xc6\x1b\xd7\x45\x28\x8b\x70\x75\xeb\xf2\xc0\x29\xae\xaa\xa0\xf7\x35\x4d\xc8\xfb\xa6\x5a\x9d\xd7\x84\x6c\xaf\xd7\x4d\x70\xd2\x86\x77\xfa\x03\xf3\x9f\x01\x00\xda\x3c\xea\xa3\x7f\x10\x00\x00"
 
 func condsTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -99,7 +105,7 @@ func condsTpl() (*asset, error) {
 	return a, nil
 }
 
-var _daoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xc1\x6e\xe3\x36\x10\x3d\x8b\x5f\x31\xd5\x49\xf2\xda\x52\x72\xdd\x5d\x1d\x9a\xa4\xbb\x08\xba\x4d\x5a\xd8\x2d\x0a\x04\x41\x41\x4b\x23\x66\x10\x8a\x54\x48\xda\x89\x1b\xf8\xdf\x0b\x92\x92\xad\xac\xd3\xcd\xc1\xb1\x67\xde\x7b\x33\xf3\x46\xa3\xb2\x84\xd5\x03\x59\x68\x49\x22\x3c\x73\x0b\x02\x15\x1a\xee\xb0\x81\xf5\x0e\x84\x5e\x34\x5c\x2f\x6a\xdd\xe0\x42\xa0\x62\xac\xe7\xf5\x23\x17\x08\xaf\xaf\x50\xfc\xfe\x28\x60\xbf\x67\x8c\xba\x5e\x1b\x07\x19\x4b\xd2\x5a\x2b\x87\x2f\x2e\x65\x49\x8a\xc6\x68\x63\xfd\x37\x83\xad\xc4\x3a\x04\xad\x33\xa4\x84\x4d\x19\x00\x40\xda\x70\xc7\xd7\xdc\x62\x69\x9f\x64\xca\x58\x92\x0a\x72\x0f\x9b\x75\x51\xeb\xae\x14\x7a\x61\x9f\xe4\xa2\x31\xb4\x45\x53\x76\xbb\x00\xc9\x19\xab\xb5\xb2\xbe\x96\x17\x28\x4b\x58\x3e\xf0\x46\x3f\x5f\xba\x97\x5f\x71\x07\xb6\xc7\x9a\x5a\x42\x0b\xee\x01\xc1\x86\x14\x50\x83\xca\x91\xdb\x01\x29\x18\xda\x2b\x58\xf2\x96\x17\xba\x82\x0a\xd2\xbf\x17\x31\x91\x8e\xfa\x5f\xb4\xa9\xf1\x37\x6e\x1d\x9a\xeb\x51\xe8\x6d\x99\xd6\x23\xa0\x0b\x10\x70\x5c\xf8\x3a\xcb\x3f\xbe\x41\xad\xbb\x0e\x95\x2b\x82\xd2\xbb\x32\x87\xaa\xe5\x2c\x88\xfc\x13\x45\x66\x25\x84\x49\xb7\xdc\x78\x4f\x85\xd4\x6b\x2e\xaf\x2e\xbc\x0c\xcc\xec\x93\x2c\xae\x2e\x7c\xba\x2c\xe1\x5a\x91\x03\x52\xe4\x88\x4b\xfa\x77\xe8\x67\x34\xd5\x0f\xab\xb0\x76\xa4\x15\x70\xd5\x00\x6f\x1a\x3b\x7a\xd2\xf1\xbe\x27\x25\x0a\xd6\x6e\x54\x1d\x64\xb2\xda\xbd\x1c\xec\xb9\x8c\xff\xe7\x50\xb7\x02\x66\xc1\x7b\x1f\x6b\x49\xe4\x90\xa1\x31\x10\x76\x9b\xc3\x6b\x98\x8d\xda\x80\xab\x2a\x50\x24\x87\x98\xff\xf3\xc0\x2a\x42\x6d\x71\x83\xcf\x59\x1a\x94\x7c\x95\x96\x04\x90\xf5\xf8\x34\x3f\xe0\x0d\xba\x8d\x51\xe1\xe7\x3e\x7c\x0e\x03\x68\x33\x0f\x5a\x1f\x2b\x88\xad\xdc\xe0\xf3\xe5\x98\xca\xea\x56\xe4\x63\x1b\x1e\xf5\xd3\xf7\x6d\xbc\x91\x3d\xba\x59\x81\x97\xba\xed\x51\x5d\x5d\x64\x87\x4a\x39\x4b\x06\xfc\x3e\x38\x7c\x29\xb5\x77\xd2\x7f\x46\x77\x27\xa6\xf6\x5a\xcb\x02\xfe\xb4\x08\xce\x1f\x10\xa9\x00\xe8\x38\x29\xf0\xb6\x06\xcc\x96\x38\x34\xd8\xa2\x19\xac\x0e\x7a\xd9\xc4\xb9\x43\x3f\x27\x7d\x8f\x99\x62\xe0\x0c\x13\xec\x0f\x9b\x5f\xf1\xb5\xc4\x2f\x84\xb2\xb1\x27\x0f\x41\xeb\xc3\xa0\x78\x87\x16\x24\x59\x07\xad\xd1\x1d\x70\x70\x9e\x03\xf1\x01\x2c\x00\x8e\xfb\x9f\x88\x65\x5b\xe0\x6a\x37\x8f\x1a\x16\x66\x77\xf7\xf1\x49\xf5\x5d\x27\xce\xaf\x61\x38\xe8\x62\xb5\xeb\xf1\xb6\xcd\xb6\x39\x4b\x66\x03\xba\x82\x8e\x3f\x62\x36\x72\xe6\x70\x36\x07\x57\xdc\x6c\xba\xa0\x9d\xe5\x39\x4b\xfc\x73\x1d\xd0\x37\xbc\xc3\xe1\x0a\x58\xd2\x6a\x03\xe4\xc5\xcf\x3e\x01\xc1\xe7\x37\xa4\x4f\x40\x1f\x3e\xf8\xea\x89\xbf\xaf\x8f\x15\xb8\x22\x66\x28\x2f\x56\x5c\x14\x5f\xd1\x65\x69\xb3\x4e\x73\x96\x24\x47\xe5\x6a\xd0\xb6\xc5\xb2\x97\xe4\x32\xc7\xc5\x1c\xd2\x79\x9a\xdf\x9d\xdd\xb3\x24\xa1\x76\xd2\x45\x55\x41\x9a\x86\x0a\x89\x3f\x02\x52\x1b\x64\x49\xb2\x67\xc9\x64\x30\xde\xf7\xa8\x9a\x6c\x08\xcc\x0f\xea\x2b\x43\xdd\xb2\xe7\x35\x66\x07\x3d\x3f\xe6\xc9\xaa\x7e\x96\xc4\x4f\x37\xc5\x43\x54\xb7\xa3\xdd\xef\x2d\xea\xbb\x35\x05\xa1\x71\x4b\x91\xcf\xd5\x2e\xac\xc7\xfc\xdf\x7e\xcc\x76\x9a\xf8\x8b\xcb\x8d\xcf\x04\x72\x7e\x6a\xbe\x79\xdf\xfd\xab\x8b\x55\xf4\xdf\xfc\x60\x01\x8e\x0b\xeb\x31\x6f\xcd\x0f\xd4\x68\x7f\xf4\x5e\xa2\xf2\x1b\xb1\x39\x7c\x86\xf3\x77\x9d\x37\xdb\xe2\x17\x89\x5d\x96\xc7\x5a\x17\x3b\xef\x6c\x36\x2d\x1d\xac\x2e\x96\xe8\x96\xa1\x56\xd0\xbb\x3b\xbb\x9f\x98\xff\x15\xdd\x6d\xdb\x5a\x74\x50\x73\x59\x6f\x24\x77\x83\xed\x3d\x17\xa4\x78\xb8\x51\x1d\x00\x83\xc9\x07\x42\xd6\x73\x81\xd7\xaa\xc1\x17\x20\xe5\xe6\x9e\x80\xdf\xa8\x0b\x2f\x5c\x97\x43\x16\x59\xf1\xc7\xe1\x9a\x8f\xa0\x38\xd4\x78\xcb\xc7\x78\x05\xe7\x67\x93\x57\xdc\xc0\x89\x75\x4e\x39\x31\x5e\xc1\xf9\x84\x12\xdf\x4f\x30\xe9\x6f\x01\xe7\x39\xcc\x8e\x45\xd8\x9e\xfd\x17\x00\x00\xff\xff\x04\x68\xbc\x95\xd6\x07\x00\x00"
+var _daoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7d\xfd\x77\xdb\xb6\xb2\xe0\xcf\xd6\x5f\x81\xea\x9c\x38\x64\x42\xd3\x76\x9a\x66\xef\x3a\x51\xef\xe6\xf3\xd6\xdb\x7c\xb4\x76\xd2\xbb\xbb\x7e\x3e\x77\x21\x12\x94\x50\x53\xa4\x4a\x50\xb2\xf5\x5c\xff\xef\x7b\x66\x30\xf8\x22\x29\xc7\x49\xdb\xb7\xbb\xaf\xe9\x49\x44\x12\x18\x0c\x66\x06\x83\xc1\x60\x30\xd8\xdf\x67\x1f\xe7\x52\xb1\x42\x96\x82\x5d\x72\xc5\x66\xa2\x12\x0d\x6f\x45\xce\xa6\x1b\x36\xab\xf7\x72\x5e\xef\x65\x75\x2e\xf6\x66\xa2\x1a\x8d\x96\x3c\xbb\xe0\x33\xc1\xae\xaf\x59\xfa\xd3\xc5\x8c\xdd\xdc\x8c\x46\x72\xb1\xac\x9b\x96\x45\xa3\x9d\x71\xb6\x58\x8e\xe1\x9f\xba\x6a\xc5\x55\x0b\x3f\x45\xd3\xd4\x8d\x82\x5f\xc5\xa2\x1d\x8f\x18\x63\xec\xfa\x7a\x8f\xc9\x82\xa5\xa7\x73\xde\xe4\xb2\x42\x20\x3b\xe3\x39\x57\xf3\xfd\xa2\x5a\xbb\x32\xa2\xca\xf5\xa7\xb2\x9e\xed\xab\xb2\x9e\x01\x94\x05\x6f\xe7\xfb\x0d\xaf\xf2\xfd\xf5\x23\x78\x6e\x44\x51\x8a\x0c\x9b\x6a\x56\x55\x2b\x17\x02\x7e\xaa\x52\x66\x02\x5b\x55\x6d\x93\xd5\x00\x75\x67\xac\xda\x46\x56\x33\xfd\x76\x53\x65\xe6\xdf\x7d\xde\xd6\x0b\x49\x8f\x2a\xe3\x65\x09\x3f\x0d\xa4\x55\x25\xa1\xf7\xfb\xab\xb6\xf8\x9b\x46\x6d\x9c\xf3\x96\x4f\xb9\x12\xfb\xea\xb7\x72\xe0\xd5\x7e\xde\xc8\xb5\x68\xc6\xa3\xd1\xce\x78\x26\xdb\xf9\x6a\x9a\x66\xf5\x62\x7f\x56\xef\xa9\xdf\xca\x3d\xfd\x71\x7f\xb1\xc1\xca\xf1\x68\x94\xd5\x95\x02\xe2\x01\x9c\xfd\x7d\x76\x3a\xe7\x79\x7d\xf9\xb2\xbd\xfa\x51\x6c\x98\x5a\x8a\x4c\x16\x52\x28\xd6\xce\x05\x53\xf8\x89\xc9\x5c\x54\xad\x6c\x37\x4c\x56\x8c\x08\x9d\x8e\x76\xc2\x7a\xd8\x53\x36\x61\xe3\xff\xb1\xa7\x3f\x8c\x0d\xfc\x37\x75\x93\x89\x77\x5c\xb5\xa2\x39\x36\x80\xc2\x66\x0a\x28\xc1\x16\x58\x84\xb5\x7c\x06\xed\x9c\xfe\xfc\x96\x65\xf5\x62\x21\xaa\x36\x45\x48\x83\x60\x6c\xab\xfb\x0f\x10\xc8\xbf\x34\x90\x07\xfb\xcc\x36\xff\x4a\x14\x7c\x55\xb6\x3f\x08\x5e\xb6\xf3\x97\x73\x91\x5d\x1c\x57\xad\x68\xd6\xbc\xec\x60\x91\xeb\x82\x4c\x9a\xcf\x75\xc1\x1a\xb1\x2c\x65\xc6\xd9\x1c\x6b\xb3\x0c\xaa\x2b\x8d\xcf\x2d\x70\x27\xec\x3b\xf6\x80\x01\x3f\xd3\x53\x91\xd5\x55\x3e\x8a\x47\xa3\x35\x6f\x40\x60\x67\x65\x3d\xe5\xe5\xab\x17\x00\x82\x3d\x50\xbf\x95\xe9\xab\x17\xe6\xed\xcb\x72\x05\xd8\xb3\x07\x99\xfe\x31\x1a\xed\xd0\x2f\xf5\x6e\xc5\x40\x72\xd2\x93\x7f\xbe\x5b\xb5\xe2\xca\x7d\x60\x8c\x4d\xd8\x82\x5f\x88\x68\xc1\x97\x67\x5a\xe0\xce\x0d\x80\x98\xed\xef\x33\x23\x29\xac\xe2\x0b\xc1\xf6\xbe\x07\x16\x56\x22\x6b\x65\x5d\x29\x40\x6c\x7f\x9f\x9d\xe8\x6e\xfe\x54\x97\x32\xf3\x99\x33\xaf\x2f\x59\x23\x78\xce\xea\x25\x8c\x50\xa8\xc1\x78\x23\xd8\x94\x97\xbc\xca\x44\xce\xf8\xa2\xae\x66\x86\x4a\x2a\x1d\xb5\x9b\xa5\xe8\x40\x93\x55\xdb\x13\xb9\x93\x7a\x55\xe5\x27\xf5\x54\x56\x4c\x89\x2a\x57\xd8\x88\x62\x6d\x8d\x8c\xd0\xc4\xde\x58\xb0\x20\x0e\xed\xaa\xa9\x34\xdd\xbd\xba\x61\x43\x13\x26\xeb\x96\x9b\x26\xde\x0a\xae\xda\x97\xae\xa7\x77\x68\x88\x5d\xca\x76\x8e\x18\x14\xe2\x52\xa8\xd6\x27\x14\xe0\xb0\x52\x42\xa3\xd0\x85\x4d\x54\xfc\xb0\x04\x9a\x42\xad\x42\xce\x56\x0d\x89\x95\x0f\x24\x6b\x84\xd1\x73\xc7\x95\x6c\x19\xaf\x72\x76\x22\x66\x12\x78\x45\xc4\x23\x20\xc5\xaa\xca\xa2\x07\x35\x3e\xa8\x78\xa4\xbf\xd1\x23\x0c\xb6\x55\xd6\xb2\x6b\x44\xc6\x52\xc9\xfb\xef\xec\xfc\x01\x0e\xf7\xf4\x25\xe2\x82\xe5\x96\x9a\x4a\xc1\x7f\x01\x01\xb1\xd4\x7c\x40\x9c\x51\x90\x5f\xad\xb4\x00\x60\x29\x25\xda\xd5\x32\x68\x91\xb1\xb3\x73\xc4\x19\x85\x4c\x8b\x61\xc2\x32\x2b\xca\x31\x43\xb5\x0c\x9c\x69\x56\x15\xe3\x05\xc8\x79\x97\x3c\x20\x5a\xf5\x52\x54\x22\x1f\xdd\x20\x45\xff\x29\xdb\x39\xe1\xa8\x18\xcf\x89\x7f\x56\x30\x52\xf6\x0f\xd1\x26\xec\xad\x54\x6d\xc2\x9e\x97\x65\xc2\x5e\xd6\xab\x4a\x93\xf5\xe7\x95\x68\x36\x54\x17\x65\x56\x89\xaa\x05\xc6\x9b\xc1\xbc\x81\x06\x08\x52\xc2\x2e\xe7\x38\x1b\x35\xb2\x15\x0a\xeb\x7b\xea\x86\xbd\x7a\xfe\x41\xb1\x95\x12\xc8\xcf\x65\x23\x17\xbc\xd9\xa4\x23\xe8\x6e\x80\x61\x94\x15\x33\xc5\xd2\x34\x0d\x88\x1f\x1b\x96\x1a\x76\x81\x28\x33\xa8\x1c\xd5\xcc\x72\x98\x98\x09\xff\xd7\xa9\xe9\x1f\x9b\x30\xbe\x5c\x8a\x2a\x8f\xdc\xbb\x84\x41\x2b\x69\x9a\xc6\x58\xe1\xa6\x4f\x29\x1a\x0e\x4a\xb4\x6e\x00\xdf\x3a\x6c\x13\x7f\x4c\x4d\x37\x46\x11\xf6\x7b\xa8\x21\x47\x24\x49\xc1\xcb\xaf\xe8\x25\x81\x99\xb0\xa5\x13\x3f\xbf\x3b\x83\x1a\x1b\x3a\x05\x5c\xb8\x83\x92\xb6\xd8\x0f\x00\x8a\xe4\xa0\x70\x7f\x45\x2f\x86\x06\xcc\xc4\xa2\xd7\xeb\xd4\xdb\x7a\x36\x13\x0d\x2b\xeb\x99\x62\x05\x97\xa5\xc8\x41\xba\x02\xfd\xda\xa2\x98\xe9\xe1\x52\x8a\xb5\x28\x51\x1e\xbd\x12\xaa\xac\x2f\x71\xf4\xf0\x0a\x68\x05\x8f\x1f\xe7\x8d\x50\xf3\xba\xcc\x4d\xf5\x4b\xde\x54\x54\x1b\xb5\x5a\x89\xed\x26\x8c\xb3\xd6\x16\x7d\x36\x61\x07\x2c\x97\x8a\x4f\x4b\xa1\x10\x0c\xfb\x0d\x46\x0d\x60\x37\x93\xd5\x2c\x05\xe8\x1f\xe7\x02\x9f\x45\x03\xb2\x58\x4a\x61\xb5\xa7\x87\x51\x5d\xe0\x1b\x1c\x27\xf4\xdb\xce\x3a\x75\xd1\x19\xe7\x1e\x67\xde\x22\xe0\x88\xe0\x3f\x00\x8b\x2b\x7d\x4b\x98\x86\xdd\xfa\xa3\x6c\x22\x8d\xe5\x0d\x28\xfd\x26\x61\x77\xd1\x5a\x0e\x12\xfc\x91\x85\xa1\xc8\x64\xc2\x2a\x59\x7a\x0d\x99\x3f\x84\x12\x32\x51\xa5\xef\xc5\x65\x34\xa6\x2a\x52\x41\x95\x71\x1c\x54\xb9\x09\x9e\xb2\xd4\x80\x67\xbb\x65\x3d\xfb\xa1\xae\x2f\xae\xf5\x9b\x23\x56\x0e\x51\xe7\x28\x7c\x0c\xa1\xf1\x3c\x7f\xab\x81\xa4\xaf\xea\x08\x7b\xeb\x93\xc6\xfc\xf7\x3c\xcf\xa1\x4c\x04\x7f\xbd\x59\x55\x99\xba\x7e\x0e\x3a\x1a\x5b\x44\x5d\x7a\xd3\x41\x39\x1e\x0d\x74\xb8\x92\xa5\x7d\x7d\x13\x07\xd2\x8f\x13\x9e\xac\x64\x2b\x79\x29\xff\x5d\xa8\x50\x4a\x9c\x78\xa0\xb4\xa3\xae\x27\xeb\x73\xc1\x97\x4b\x5f\x1a\xbd\xa2\x32\xb4\xdc\xea\x4a\x24\x58\x5d\x2a\xc6\x4b\x55\xb3\x86\xe6\x56\x91\xb3\x55\x95\x8b\x26\x6c\x13\x99\x5e\x17\xa0\x50\x49\x26\x01\xc7\x28\x6b\xaf\xac\x95\xfb\x52\xff\x8b\x4a\x97\x05\x6a\x3d\x61\xf5\xb2\x45\x6d\xaf\x55\x46\xcc\x22\xd1\x34\x5a\x5e\x0c\x7d\x25\xc2\xee\x4b\x09\x14\x9c\x04\xc2\x81\x90\xc9\x72\xe8\x8b\x88\x26\x2e\x51\x13\xfe\xce\x12\xa8\xcd\x8e\x26\xa0\x38\x2a\x32\x1b\x61\xfa\x49\x5f\xbd\x78\xcf\x17\x02\xf1\xd5\x18\xc6\x06\x13\xa8\xf0\x4d\x17\x93\x3e\x64\x6b\x6f\xa6\x6f\xeb\xec\x22\x8a\x83\xb7\x67\xae\x89\x73\x36\x61\x99\x67\xce\x4e\x58\x96\xd2\xd4\xd8\x35\x67\xa1\x60\x07\xf6\xa7\xaa\xd4\xd0\x77\x08\x83\x1b\xb2\x43\x35\xbf\x2c\xe3\xba\x26\x54\x4f\xbb\x00\x0f\x71\xf6\xce\xe6\x40\xb7\x95\xd2\xd6\x95\xd1\x46\x00\x94\xaa\xb4\x5a\xcd\xb9\xc5\x66\xd1\xd4\x0b\x50\xa2\xad\x85\x96\xb2\x8f\x3d\xbd\x66\x74\x9a\x29\xa3\xd0\x4a\xac\x57\x2d\x80\xe6\xbe\x84\x79\x58\xd2\x68\xe0\xa4\x01\x12\xa6\x84\x60\x33\xd1\x12\x45\x48\xda\x4c\x6f\x87\x25\x2e\x54\x49\xff\x1f\x88\x5f\xf5\x57\x0a\x5e\x65\x45\x6e\x9b\x24\x79\x50\xb5\x30\x39\x7a\xd3\xfb\x9e\x30\x79\xcc\x2b\xea\x01\xe5\x90\xb0\xba\xe9\x2a\x18\x2c\x09\x8c\x5d\x2c\xdb\x0d\x96\xc2\x69\xf2\xb8\x65\x79\x2d\x14\xab\xea\x96\x15\xbc\x2c\xd9\x94\x67\x17\x66\xa6\x34\xd5\xbd\xa6\x01\x4a\xdd\xce\x45\x83\x20\x14\x88\x70\xad\x04\x53\xa2\x59\x8b\x06\x9a\x55\xd9\x5c\x2c\x38\x5b\xf0\x0d\xc2\x9c\xf3\xb5\xf0\xc4\x98\x24\xc8\x75\xd1\x9f\xc1\x62\x16\x99\x09\x2c\x09\x25\xc2\xa3\xdb\x89\x47\xe5\x5c\x14\xa2\xf1\x89\x7a\x12\x50\x15\xe4\x28\x61\xf5\x05\xe8\x1b\x53\xe8\x0c\xda\x3b\x7f\x0a\x6f\xbb\x4c\x85\xc2\x66\x1e\xb8\x31\x00\xa0\x38\x48\xe2\x78\xec\x95\x97\x05\xeb\x68\x8a\xae\x98\x78\x50\x2b\x59\x26\x81\xd0\x5a\x56\x79\x64\x05\xd5\x59\xfb\xd3\x4c\xee\xc9\xf1\xcd\xa8\x03\x32\x68\xbc\x8b\xb4\xdf\x6c\xb1\x68\xd3\xd7\x30\xb4\x8a\x68\x5c\xd5\x3e\x1f\xa5\xf2\x55\x00\x70\xd5\xa2\x75\x4f\xe9\x71\xdf\x5b\xe9\x8d\xf5\xe0\x8e\x69\x62\x7c\x59\x02\xe7\x33\xf8\xbb\x27\xa1\xcb\xba\x2e\x55\xca\x3e\xe1\xda\x43\xe2\x2a\x14\x4a\x2c\xb8\xd4\x66\x29\x16\x5a\x4b\x0e\x12\x6a\xf5\x0a\x02\x8c\x06\x78\x7e\x1b\xcb\x03\x8e\x23\x32\x39\x3b\xf2\x3c\x0b\x46\xa2\xce\xf5\xe2\xf3\xfa\x26\x61\xa5\xa8\x22\x03\x21\xd6\x64\x06\x02\x90\x1e\x80\xda\x0d\xaf\x66\xc2\xb6\xe2\xf1\x55\x16\xec\x5f\x4e\xa4\xa0\xb1\xb3\xec\xfc\x29\xfb\x26\x10\x27\xf8\x3f\x4b\xf1\x73\x14\x87\x6f\x4d\x15\x36\xa1\xc5\xf0\xf5\xcd\xf5\xcd\x00\xa3\x73\x51\x8a\x56\x58\x2c\x0d\xe1\x5d\xa1\x01\x44\x02\xa9\x38\x7f\xda\x11\x51\xd2\x64\xbb\xbb\x1d\x64\x83\x52\x01\xd2\x37\xa3\xde\x77\x36\xb1\xc2\xe6\x4d\xa0\xf0\xea\x86\x96\xfa\x34\x95\x3a\xef\x1a\xf6\x91\x3e\x2a\xa1\x94\xac\xab\xde\x47\x5a\x63\xfc\xa4\xeb\x92\x04\x2b\xc6\xcd\xe4\x42\x4a\x66\xc8\xa3\x63\x56\xc7\xc3\x2b\x5c\x82\x38\x34\x51\xc5\xdd\x17\xec\xda\x1f\x3e\xe6\x23\x40\xf9\x85\x97\x2b\x01\x30\x12\xd3\x84\xee\x01\xc8\x52\xdb\xac\xec\x80\x80\xb2\xa7\xba\x8b\x83\x7d\x90\xd9\x9c\x2d\x65\xa5\x7a\x1d\x09\xf1\x07\xb5\x5c\x57\x99\x60\x5c\xaf\xeb\x5d\x49\x36\xe7\x8a\x4d\x85\xa8\x98\xb8\x12\xd9\x0a\x8c\x01\x98\xd4\x99\x6c\x13\xa6\x00\x06\x2d\xa2\x00\xbe\xc2\x31\x0c\x64\x41\x20\xfe\xba\x92\x70\xfc\xf3\xa8\x12\xf0\x15\xa8\x52\x89\xcb\x48\xfb\x8a\xd3\x17\x75\x5d\xc6\x86\x42\x2b\x25\x1c\x93\xc1\x15\xae\xd8\xe5\x5c\xe0\x74\xd2\xa5\x09\x76\x0c\x30\x5c\xac\x54\xcb\xa6\xb7\x71\xda\x41\x1d\xee\xd2\xb4\xae\x8d\x6e\x96\x05\x5b\xdb\x61\xd3\x5e\xa5\x9a\xb5\x1d\xae\xc6\x69\x04\x55\x62\x9c\x25\x76\x77\xd9\x9a\x2a\x7b\x84\x00\xb6\x6f\x1b\x8d\x16\x6c\x7b\xe5\x41\x7c\xd0\x5e\x9d\xb6\xbc\x15\xf1\xf0\xdc\xd3\x01\x08\x3c\x6b\x45\xd5\x87\xd9\x21\x35\x00\xf6\x09\xed\x33\x4b\x23\x4f\x90\xd2\xb7\x35\xcf\x23\xc3\x87\x05\x6f\x2e\xfe\xa9\x3f\xb0\x46\x64\x75\x93\xab\x01\x69\x23\xa5\x4d\x4d\x82\x31\x89\x63\x40\x16\x8c\x57\x86\xf6\x1e\xa4\x61\xe2\x5b\xba\x7f\x6d\x97\x3a\xf4\x32\xfd\x39\x6d\xeb\x46\x44\x40\x36\xa3\xad\x6e\x8c\xaf\x9a\x3c\xa9\xaf\x9b\xe6\x7d\xdd\xbe\x01\x17\x11\xd8\x86\x9a\xd0\xda\xcc\x7e\x23\x1b\xd5\x02\xd7\xaa\x9a\xfa\xcf\x16\xc2\xb8\x68\x32\x18\x2f\x8d\xe4\xda\x67\xea\x43\x09\x8d\x4f\xaa\x08\x56\x4e\x01\x8d\xd0\x8c\xad\x5b\x7e\xb5\x42\xdf\x4e\x2b\x60\x73\x62\xc1\xdb\x6c\x4e\x93\xa4\x86\x00\xc4\xcc\x4d\x11\xb6\x06\x3a\xe0\xbb\x55\x25\x7f\x5b\x81\x97\x28\x17\x57\x42\x25\xec\xdd\x06\xf6\x13\xa8\xce\xe1\xc1\x93\x47\x38\x21\x1f\x7e\xf7\xb7\x27\x16\xbb\xa0\xa5\x10\x43\xd7\xc2\x85\xd8\x04\xe8\xbd\xa9\x1b\x21\x67\xd5\x8f\x62\xf3\x8b\xac\x4b\xcd\xee\x61\x2c\x0b\x5d\x92\x5d\x88\x0d\x30\x57\xb5\x0d\x97\x55\xdb\x43\xed\xd1\xe1\x93\x84\x1d\x3e\x3a\xfc\x2f\x09\x3b\x7c\xfc\xdd\xa1\x46\xf3\xf1\x77\x8f\x2c\x9a\x43\x2d\x86\xd8\xfa\x2d\xad\x4d\x99\x90\xa8\x82\xe7\x30\xdb\x07\xa8\xe6\xe6\xa5\x86\x15\x20\x06\x18\x7d\xeb\x28\x65\x4a\x76\xa8\x44\xaf\x83\xa6\xc0\xe4\xf8\x27\x97\xed\x47\xb9\x10\xf5\xaa\x0d\x5a\x44\x14\x2e\xb9\x6c\xd1\x09\x07\x5f\x87\x9b\x3e\xf8\xce\x36\xdd\x05\x17\x62\xd0\x03\x38\x8e\xc9\x35\x8f\xc6\x1b\x93\xca\x2e\xc9\x80\x25\x3c\x74\xbd\xa5\x06\xd6\xb1\xb2\x68\xca\x56\xb1\x1f\x65\x95\x6b\xb7\x82\xfb\xee\x3d\x3d\x57\x5a\x13\xb4\x99\xde\x3b\xd0\xdb\x6d\x66\xe1\x27\xd2\x99\x07\x17\xd6\x68\x49\x57\xd8\x62\x30\xf7\x2d\x30\x5d\x64\x17\x57\x64\xaf\x9b\x26\xa6\x6d\x01\xdd\x81\xc0\xf1\xff\x11\x16\xb4\x40\x17\x32\xfb\xf1\xe5\x07\xab\x75\xbc\x97\xd0\x03\xbb\xf4\xab\x9b\xfe\xb8\xee\xe1\x94\x6c\x11\xb5\x24\xe0\x7f\xdd\x0c\xf0\x04\x5b\x3c\xae\x72\x71\xe5\xe1\xa6\x5b\xf4\x47\x25\x89\xa6\xd6\x23\xbd\xd6\xf5\xea\x4a\xc2\x40\xbe\xa8\xea\x4b\xbd\x50\x7c\x59\x97\xab\x45\x05\xdb\x0e\x67\xe7\x04\x76\x7f\x9f\x65\xf4\xb6\x2e\x74\xab\x46\x54\x58\xbf\xc7\x21\x6b\x60\xa5\x01\xf6\xaa\x47\x87\xd1\x8d\x2f\x2d\x8b\x65\x29\x60\xff\xd1\x1b\xca\xda\xb1\x5b\xf0\x4c\x90\xde\x8e\x04\x7b\x80\xbc\x89\x75\xad\x28\x36\x3d\xd6\x0a\x7b\xa1\x66\x30\xf1\x88\xd4\x31\xe6\x21\x1b\x1f\xb1\x31\x7b\xc8\x44\x0a\x8c\x49\xa9\x9e\xd1\xef\x22\xd5\x8c\xfd\xa6\xb3\x58\x02\x48\x13\xfb\xf5\x21\x1b\x23\x8c\x85\x9a\x79\xd3\x1d\xb8\x79\x52\xa4\xc2\x60\xf5\x87\x13\x36\x66\xb0\x4a\xc2\x12\x50\x9d\x4a\xdb\x52\xe0\xda\x14\x55\x24\x52\xa2\x75\x0c\x70\x0e\x3c\x30\x01\xa8\x08\x40\xd0\x1e\x77\xfa\xdf\x6b\xe9\x55\x4c\xd8\x38\x61\xe3\x98\x3d\x64\xe3\x78\x6c\x6b\xdf\x74\x71\x7d\x3d\xe4\x1b\x30\xf0\x0d\x95\x5e\x37\x4d\x40\xa4\x60\x7d\x06\x04\xd0\x5c\xfb\x54\x5d\x36\x7c\x49\xef\x35\xcf\x2e\x40\xf0\x61\xac\x76\x07\x66\x9f\x7b\xba\x76\x14\xb3\xb3\x73\xdf\xe7\x6b\xb1\xec\x79\x51\xa8\x7d\x2a\x7e\xad\x99\x79\xd3\x47\x30\x2c\x00\x1a\xe1\x75\xd3\x18\xa7\xe8\x82\x2f\xb1\x67\x0c\x1a\xd7\x38\x7b\xba\xcf\x3a\xd3\xc1\x66\x93\x95\x28\xcd\x6b\x59\xb5\x35\x61\xae\x35\x93\xe9\xb4\x76\x29\x50\x29\x8e\x00\x37\xb0\xf5\x43\xfd\x35\xcd\x45\xe8\x45\x48\x3c\x1b\xc5\x38\x9a\xf4\x10\x45\xa1\x10\x8a\x79\x5b\xca\x66\xc8\x25\xcc\xf3\x33\xf9\x94\x02\x9b\xc1\x68\x2d\xe3\xab\xc2\xbe\x20\x9a\x96\x98\x8e\x94\xbf\xff\xce\xbe\xd9\xaa\xf6\xfa\x84\x16\x4d\xe3\x11\x57\xb0\xa3\x09\xdb\x45\xd0\xd7\x38\x22\x8e\x18\xf5\xc9\x0e\xb4\x23\xd7\x3d\x54\x5b\x47\x80\xb9\x66\x8d\xba\x94\xa0\xae\x4d\x6b\xe9\xfb\xd5\x62\x2a\x4c\x47\x32\xf0\x0d\x83\x8d\x90\xa0\x81\x70\x64\x11\xd1\x1c\x64\x93\xae\xba\xf2\x0a\x20\xe1\x12\x66\x87\x01\x9b\x38\xf3\xe4\x47\xb1\xc1\xcf\x91\x6d\xf6\x9d\x50\x8a\xcf\x44\x87\xea\xb4\x04\x47\x2c\x3a\xe6\x00\x1a\x05\x8f\x86\x31\x1a\xd0\xd6\x01\xa0\x6f\xb7\x74\x84\x94\xb9\x5f\xf6\xe0\xbb\xe1\xb2\x43\x9a\x9e\x7c\x5b\x47\xb7\xb3\xcb\xbc\x24\xb1\xef\xd1\x84\x6a\xa1\xc0\x86\xd3\x04\xce\xd3\xb6\x3c\x13\x55\xdb\x6c\xd8\x42\x13\x0e\x45\x1f\xa6\x68\x9a\x03\x12\x18\x52\x38\xe3\x8e\x5f\x75\x6a\xdc\x2f\xea\xfa\x3e\xaa\x7b\x30\x8b\xee\xaf\x94\x68\x54\x2a\x16\x5c\x96\xf7\xc7\x30\xca\x50\x52\xd9\xdf\xd0\xe3\x37\x4e\xd3\xd4\x15\xa5\x42\x34\x82\x7a\x98\x47\x06\x97\x3b\x8f\xa0\x98\x45\xa6\xac\x7b\x45\xda\x06\xa4\xda\x28\xd4\xb7\x5c\xb5\x41\x13\x09\x1b\x3b\xb4\xc8\xca\x92\x05\x93\xec\x59\xa0\xa2\x89\xd6\xe3\x71\xcf\x0d\x07\xc0\xfc\x16\x3e\x36\x72\x71\xba\x2a\x0a\x69\x9b\x38\x93\x0f\xc1\xab\x13\xb4\x73\x74\x9e\xb0\xb1\xd7\x9e\x21\x36\x2d\x40\x82\xfe\x9e\x21\xd3\xb6\xf8\x05\xf1\x5b\x62\x98\xe5\x21\xb6\xbf\x6f\xe8\xcf\x7e\x5b\xf1\xd2\x85\xe4\x60\x0d\xe3\xcf\x5f\xce\x37\x4a\x66\xb0\x65\xab\x07\xba\x76\xfa\xe7\xb2\x28\x44\xa3\x10\x61\x05\xe1\x5d\x22\x27\x1f\xa9\xc1\xf7\xd7\x41\xa2\xbe\xd8\xb4\x22\x22\x8c\xee\xa7\xf7\xe3\xa7\xec\x57\xf6\x7d\x38\xd7\xe1\x57\x36\xd1\x74\x3b\xfb\xf5\xe1\xe1\xd1\xb9\x87\x74\xd8\xa9\x21\x2a\x90\xb0\xbf\xc4\xfe\x9a\x5d\x79\x1b\x72\xa4\xd1\xef\xac\x15\xad\xc6\xc7\x6d\x07\x70\xfe\x2a\xd1\xa2\xf3\x03\x60\x90\x2d\x18\x00\x1c\x0a\xaf\xd1\x05\x60\x39\x09\x84\x30\x04\x87\x79\xc0\x2c\x63\x81\xa6\xc7\x95\x12\x4d\x9b\xd0\xbf\xef\x78\xb5\xc1\xf1\xf4\x69\x99\xf3\x96\x02\x5d\x3a\x80\x82\x86\xc3\x70\x1b\xfd\x49\x83\xfa\x50\x95\x9b\xdb\x1b\xc5\x86\xbc\x76\xa7\xab\x16\xfd\xb6\xba\x6d\xb2\x9a\x9f\x7f\xfa\xf8\xe1\x5f\xc7\xef\x5f\x9e\xbc\x7e\xf7\xfa\xfd\x47\x03\xd0\x47\xcc\x35\x17\xa2\x71\x22\x78\xde\x43\xa2\x12\xe0\x59\x27\x54\xa8\x09\xb7\x19\x34\x00\xdd\x40\xa1\x25\xc4\x29\x0e\xcb\x40\x51\x99\x0d\x44\x94\x4e\xe4\x2f\x69\x89\x68\x49\x30\x4c\x70\x84\xae\xdc\x35\x0e\x69\x1a\x5a\xd2\x23\x4e\x3c\xdd\x9e\xf5\x14\xeb\x58\x62\xaf\xf7\xea\xaa\xdc\x8c\xbb\xf5\x0c\xce\xfd\x5a\xe0\x17\xf2\xea\x04\x32\x3c\xbe\x24\x0e\x8f\x49\x64\x5f\x37\x8d\xc6\xe3\x7d\xdd\x5a\xee\xfb\x6b\xff\xcb\xb9\xa8\x86\x04\xa8\x6e\x88\x87\x36\x94\x86\x28\x8b\x6a\x3a\x90\x9f\xa2\x6e\xa6\x32\x57\x29\x3a\x1b\x06\x1b\x0c\x17\x77\x06\x8e\xf6\xf0\x5b\x8c\x35\x73\x30\x30\xcf\x03\x0f\x0a\xc4\x70\x6a\xb8\x33\x9a\x6b\xe4\x31\x00\x68\xc2\xe1\x6a\xf9\x09\x60\x34\x9e\xd0\x88\xd9\x23\xf2\xcc\x0a\x78\x34\x42\xb6\x90\x4a\x01\x6f\x71\x5f\xd1\x56\x37\xf2\x0f\xad\x92\x74\x0c\x20\xab\x0d\x32\x92\x8e\xc4\x35\xee\x4d\x1f\x5e\x85\x4d\x62\x10\xf7\xbe\xf3\x6a\x93\xb0\x95\x26\x3e\xba\xdd\x02\xeb\x0c\x70\x2f\xa4\x28\x73\xe7\x96\x27\x10\x9e\xbe\x2b\x48\x8a\xa1\x8c\x41\xe1\x0c\x6b\x9d\x3f\x35\x9f\x26\x93\x8e\xa8\xb1\xdf\x7f\x67\x11\xb5\xbb\xbb\xdb\x2b\xe6\x24\xd9\x4c\x72\x1d\xc9\xf4\xf7\x56\xee\x5d\x1e\x19\x1e\xdc\x53\xe9\x3d\x05\xdc\xbe\xa7\xc6\xc9\x20\x0f\x13\x33\x11\x20\x86\x44\xb4\x4d\x77\xbb\x27\x10\x74\xf2\xb0\x6b\x09\x3f\xae\xd6\xbc\x94\xf9\x2f\x86\x92\xce\x31\xf1\xe0\x17\xf8\x80\x2c\x7e\x6d\x9c\x05\x44\x2d\x72\xa8\x54\x33\xb3\x53\x63\x1c\x39\x46\x0f\x58\x45\x42\x62\x1d\xb6\x12\x8a\xb4\xd4\xdf\x88\x99\x24\xca\x6f\xa0\x37\xba\xd9\x5c\xa8\xac\x91\x53\x90\x22\x5d\xc6\xf8\x73\xaa\x19\xe3\x5e\xe3\xd0\xb6\x33\x83\x68\x96\xf0\xe0\x04\x6e\x03\xcd\x3d\x12\x35\x7c\x73\xb2\x42\x3f\x02\xa9\x26\x8c\x8a\xfb\x6d\x25\x1b\x91\x27\x6c\xc1\xaf\xfe\x55\x8a\x6a\xd6\xce\x13\xb6\x90\x15\xbe\x00\x13\x49\x54\xab\x05\x45\xc1\xb6\x5c\x96\x04\x8d\x59\xf3\x4b\x5c\x65\x42\xe4\x8a\x3d\x79\xcc\xb2\x39\x6f\x78\x06\xfb\x3f\x46\xbb\x74\xa9\x5b\x4a\xd5\x2a\x06\xea\x79\x43\xdd\x44\xa3\x8f\xfc\x82\x77\xa5\x38\x10\x81\x93\x48\x40\x2b\x7a\x7e\x85\xe1\x97\x0b\x58\xf7\xdb\x0d\x7f\x53\x03\xf6\xc0\x70\xa3\xb0\xae\x54\x6a\x90\xb2\xfa\x1c\x56\x5c\xad\xaf\x46\x60\x73\xc0\x4d\x5b\xbe\xd6\x83\xd6\xdc\xcc\x69\x64\x4d\x82\xb7\x1d\x7c\x71\xa8\x0a\x21\x80\x65\xc0\xbf\xd4\x15\x10\xe2\x5d\x97\x42\x43\x7e\x1f\x8f\x81\xc8\x6a\xc5\xce\xce\x1d\xcf\xbf\xce\x93\xd1\x69\x77\x8b\x4f\x23\x47\x9e\x2b\xbb\x3d\x68\xac\xd8\x84\x1d\x24\xe4\x3e\x40\x44\xfc\x4d\xc1\x7f\x25\x5d\xf5\x63\x0a\x11\x50\x1f\xb0\x8d\xd2\xa2\x17\x54\x97\x16\x55\x0f\xc7\x6c\xfc\x50\xbf\x78\x85\xdf\x07\xbc\x02\x5d\x37\x49\x8f\xce\xa6\x63\xd6\x19\x63\x0c\x44\x74\x63\xd8\x76\xd1\x89\x41\xb4\x3c\x56\xbd\xcd\x96\x96\x37\x33\xd1\x32\x39\xc4\xc8\xed\x44\x3d\x56\x11\x55\xa4\x65\xb4\xb7\xb1\x42\xf8\xd3\xf7\xc9\xa4\x07\x98\x90\xd1\x22\xfc\xd2\xa9\x00\x67\x58\x0e\x0c\x13\x3b\xb3\x05\x43\x01\x54\x86\x1b\x03\x24\x7a\x7d\xc0\xbe\xec\x11\x18\xab\x2d\x10\xe7\xaa\x6e\xdf\xaf\xca\x92\x31\xdd\x11\x32\xc5\xbc\xe9\x35\xe3\x15\xcc\xd9\x53\xc1\xde\x7f\x7a\xfb\x96\xfa\xa9\x55\x4c\x58\x47\x9b\x36\x4a\xef\x55\x81\xe5\xeb\x46\x2b\xd6\x5a\xf0\xab\xb7\xa8\x8d\xc0\x0f\xc7\x4c\xad\x05\xbf\x92\x8b\xd5\xc2\xd3\x34\x30\x88\x5f\xfe\xf0\xfc\x04\xed\xcc\x5f\x9e\x9f\xe0\x6f\xd2\x13\x08\x08\x37\xa6\x73\x36\x80\x32\x0c\x0b\x08\xed\x23\xd4\x41\x7f\x4c\x45\x7b\x09\x9b\x85\x0b\x59\x21\xc0\x05\xbf\x42\x20\xf0\x6c\x56\x0a\xed\x93\xc7\x06\x43\x7a\xb7\x72\x2f\x09\x56\xc7\x71\x49\x6f\xeb\x82\xbd\x7e\xff\xe9\x1d\x75\x53\x25\x6c\x21\xc0\x19\x81\x7c\x3b\x7d\x6d\xed\x5e\x56\x37\x5d\xdc\xea\x82\xfd\x37\xab\x88\xa5\x3a\x15\x2d\x33\x3d\x22\x29\x59\x93\x52\xa3\xd9\xc7\xa8\x32\xde\x13\xca\x8e\x3d\xd4\xd7\xbf\xa2\x2b\x56\x89\xd1\x7a\x01\xcf\x30\xea\xce\x30\xce\xf2\x98\xba\x40\x63\x22\x44\xaa\x63\xf9\xf8\x82\x7b\x76\xde\x15\xc6\xad\x76\x8f\x46\x62\xc0\xee\x81\x59\xb8\x18\x50\x8f\x9e\x5e\xca\x9c\x4e\xf2\x5b\x77\x6a\x09\xdb\x34\xcb\x5d\x7c\x50\x67\x59\xaa\x71\x3b\xb7\xa5\x64\x31\x10\xbe\x00\xcb\x73\x8d\xda\xee\x2e\xcb\x52\x4b\x91\xb0\x14\xfc\x21\x24\xad\xea\xd3\xcf\x89\x67\x0e\x5c\x6b\xf5\x77\xc4\x4c\xdb\x09\xce\xdc\x47\x60\xdb\x6b\xb0\xe3\x84\xe6\xe3\x23\x36\x46\x6b\x9d\x5e\x53\x24\x66\x3f\x3c\x02\xfe\xc0\x46\xb5\xac\x68\xd7\x34\xfc\x2c\x0b\xa7\xb4\xb3\x14\xcd\xd6\x08\xfb\x1f\x3f\xa5\x0f\x3d\xdf\xed\x6d\x5d\x79\x80\x3f\x86\x0d\x34\x72\x3c\x63\x09\x15\xb3\x49\xb8\x12\x27\xad\x18\xba\x33\xe8\xe5\x6e\x47\x94\x3b\xee\x40\xa4\x9f\x3a\x22\xa4\x8c\xdf\x15\xfb\x42\x10\xb4\xc6\x24\x33\xc3\x85\x18\x3a\x59\x80\xc5\x32\x19\x60\xe8\x1f\xaa\x64\x99\xb2\x9f\x6a\x18\x8d\xe0\x65\xad\x72\x72\x2d\xeb\x7d\x58\x33\x3c\x9d\x33\x0a\x3c\xa1\xa0\x20\x4f\x49\xc6\x41\xa7\x20\x02\x36\x56\x51\xda\x4a\xd6\x5b\xed\x46\xa2\xf6\xe9\x82\x72\x06\x4c\x79\x45\xd8\x81\x04\xdc\x0f\x5b\x99\xae\x64\x09\x51\xae\xe2\x6a\xd9\xe8\xcd\x60\xa5\x5b\x2b\x45\x81\x7b\xfe\xe8\x65\x31\x53\x53\xc6\xba\xc3\x2b\x66\x1e\x8f\x61\x73\x3a\x66\x0f\x9c\xfc\x11\x3f\x9a\x35\x88\x03\x1d\x82\xd3\x5d\xfe\x50\x90\x58\xd8\x61\xd5\xac\xd1\x57\x1a\xc5\x6c\xe2\xca\x12\xc9\x30\x76\xa6\x59\xa7\xc7\xea\xbd\x2c\x6d\x98\x12\x81\x9e\xb0\x66\x9d\xbe\x2e\xc5\x22\xf0\xf5\xcb\x02\x5e\x1f\x2b\x64\x75\x14\x03\x04\xd7\xc2\x37\x83\x2d\x34\xeb\xf4\x25\xaf\x8e\x8d\x91\x13\xb4\x83\xc8\xea\xa6\xbc\x02\xf6\x33\xc5\x19\x3b\xe7\x00\xe8\xe7\x04\x95\x31\x70\x07\x75\x2e\xf1\x03\x36\xa7\x02\x06\x5a\x20\xb0\x71\x50\xad\x16\x81\xda\x48\x23\x6b\x74\x5d\x33\xd7\x1b\xd0\x5a\xec\xc6\x86\x49\x80\x05\x95\xa5\x58\x61\x78\xff\x05\x34\x0d\xe0\xe0\x11\x24\x2c\xe0\x8f\x8e\xbb\xe8\x0f\x00\xd6\xd1\x1d\x30\x5f\x9b\x45\x87\x1d\x10\x20\x72\xe3\x50\x7b\xdc\x8c\x06\x1a\x35\xe3\x34\x2c\x20\x69\x11\xd5\x74\x68\x12\x0c\x9e\x6e\x98\x02\xfc\x59\xdb\x70\x53\xac\x41\x45\xa3\xb8\x4b\x94\xc1\x40\xd3\x5b\x50\x1b\xc0\x7f\xcd\x06\x44\x7b\x58\x63\x91\xf3\xc6\xc9\xe1\xb5\x73\xc6\x18\x10\x64\xb9\x25\x5d\x01\x75\x2f\x4e\xe1\xd8\xa8\x7b\x7c\xc7\x97\xce\x75\x23\x0b\x16\x7d\x13\xc8\xfd\xef\xbf\x33\x37\x70\x70\x18\x64\xa9\xb1\xbe\xae\x47\x03\xdd\xdd\xfd\xda\xe9\x23\x34\xd9\x3c\xa6\xdf\xf4\x7b\xa9\xf5\x9a\xc3\x1b\x57\x06\xcd\x3a\x35\x4e\x2f\xfb\x01\x9c\xc7\xa9\xb3\xe1\xbe\x67\x07\xd0\x05\x38\xe7\x9a\x9e\xac\x2a\x81\x87\xb7\x8e\x2b\xaa\xa6\x62\xf6\x7d\x50\xfc\xeb\x3b\xe8\x56\xb1\x5e\x17\xc1\xef\x70\xba\x6c\x64\xd5\x16\x91\x5d\xaf\xde\xcb\x3d\x2b\x72\x9c\xf8\xed\xc7\x5d\x1a\xc0\x1f\x63\xab\x1d\x4d\xac\x69\x77\xad\xdc\x67\xec\xb0\xb6\xcc\x42\xec\x4d\x3d\xe7\x98\x3e\x5d\x96\xb2\x8d\x70\xc9\x31\x8e\x83\xb2\xb2\x60\xaa\x17\x3a\xdb\x07\xb4\x5d\xb2\xdd\x2f\xb2\x78\x74\x25\x67\xf6\x18\x20\x21\x78\x59\x0c\xa9\x22\x50\xde\xfa\xac\x33\xc6\x26\x71\x59\x29\x5b\xc4\x40\xfe\x7f\x42\x1f\x0d\x08\xea\x31\x58\x90\xde\xc3\xdf\x82\xa7\xc3\x27\xc1\xe3\xb7\x8f\x82\xc7\x27\x8f\x9d\x80\x57\x24\xe0\xc7\x55\xdb\x95\x6e\x5a\x58\xec\xee\xb2\x8a\x3d\x03\xf1\x91\xe6\x94\xd2\xd7\x10\x62\x21\xab\x6d\x12\x2b\x15\x2b\x85\x22\x8b\xe0\x1e\x8c\x5d\x6c\x6c\x50\x4a\x7b\x98\x99\x91\x87\x4b\x94\xa8\xb2\x23\xed\x8f\xa0\xca\xaf\x6e\x41\x75\x86\x07\x5f\x9b\x10\x5b\x7e\xb5\x0d\xdb\x2f\x96\x3b\x3a\x73\x9f\xbe\xa9\x9b\x05\x6f\x81\x2f\x55\xc2\x0e\x0f\xe2\xf8\x0f\xf4\xe8\x2b\xa5\x70\x40\xee\x3e\x49\x5f\xf0\x3e\xc9\x40\xf2\x3e\xc9\x50\xf4\x3e\xc9\x50\xf6\x3e\xc9\x61\xe1\xfb\x24\x6f\x97\xbe\xff\x5c\x2c\xfd\x24\xff\xef\xf3\x74\x8b\x2b\x99\x12\x01\x9c\x88\xb6\xd9\xf4\x76\xf9\x40\x44\x1a\xf8\x62\x1c\xe3\x75\xc1\x2a\x71\x09\x91\x67\xca\x6d\x61\xd2\x11\x4c\x28\x08\x1e\x1c\xff\x0c\xee\x9a\x37\x43\x2d\x78\xbf\xe9\xe4\xd4\x6d\xad\xc3\xef\xba\xe8\x6f\x36\xc2\x09\x54\xf0\x46\x60\x90\xb0\x17\x0c\x90\x0c\xed\xf6\x43\x38\x75\xc3\xb8\x7f\x3c\xa1\x11\x0a\x0e\x60\x63\xf5\x5f\x61\x63\x0d\xce\x41\x89\xab\x65\x5d\x81\xfb\x94\xeb\x53\x30\x75\x51\xa4\x2e\x2a\x03\x82\x57\xe0\x14\x6a\xc3\x2b\xc5\xed\x99\xae\x8f\xee\x11\x7a\x03\x6b\x16\xe0\x8b\xc6\x1d\xa2\xef\x74\x5f\xfc\x5a\xd2\xd0\x2b\x87\xaf\x1c\x62\xdb\x4b\x61\x33\x10\x78\xd4\xf0\xfd\x57\xef\xf8\xd5\xf3\xb6\x85\xe8\x32\xe5\x5c\x48\xc6\xe8\xe7\xee\x4b\x56\xae\x72\xe3\x72\x2e\x30\xc8\x15\xcf\x16\x1a\x5a\x02\x7e\xc4\xb5\x1c\x46\x9e\xd3\xc4\x8f\x50\x46\x5e\x70\x25\x5e\x89\x92\xc3\x71\xfb\xe0\xe4\x2a\x34\x93\xe3\x07\xf2\x0d\xbb\x06\x00\xf6\x26\x61\x79\xbd\x42\xa8\x30\x55\x6b\x97\x78\x05\xe1\xe3\xf8\xd5\x74\xc1\x80\xee\xc3\x36\xae\x30\x6c\x03\x76\xa5\x4b\xb9\x90\xb0\x84\x91\x05\x3b\x30\x9b\xa4\xc7\xb9\x58\x2c\xeb\x56\x54\xed\x31\xf9\x6e\x78\x59\xd6\x97\x9a\x9e\x1b\xe8\xb6\xf1\xe9\x70\x38\x02\xda\x63\xb8\x4a\xd9\x73\xfd\xcb\x38\x37\x60\xa3\x1a\x4f\x28\x41\x50\xbd\x69\x46\x1f\x17\xce\x31\xa4\x5e\x54\x20\xe1\x4c\xc2\x19\xcd\x72\x03\x24\x83\xa3\x73\x4b\xd0\x20\xc6\x83\x04\x5e\xd4\x39\x6f\x16\x40\x4b\xda\x9e\x6d\xc4\xaf\x22\xa3\x7c\x09\x9c\xb6\xd8\x21\xfa\x41\xef\x01\xf7\xbb\x01\x0b\xa9\x7e\x27\xb5\x5b\xbe\xdf\xc9\x95\x79\xff\xb9\x4e\xd2\xe6\xd6\x57\x74\x12\xb8\x6b\x9a\x01\x48\x7c\xaa\xea\x72\x65\x23\x95\x13\x9c\x50\x3a\xab\xf7\xf4\x84\x5f\x46\x63\x08\xea\x85\x50\xc1\xc3\x71\x9c\x9a\xb6\x5e\xe1\x61\x16\x2d\x7d\x56\xf4\x87\x91\xd7\x5b\x15\xd9\x4a\xb5\xf5\x82\xbd\xbe\x12\x19\x53\x10\x34\xaf\xf7\x00\xf4\x4e\x38\x46\x85\x85\x74\x34\x94\xf2\x3c\x88\x79\xcd\x9a\x55\xa5\x58\x51\x31\xc8\x75\x53\x02\x0b\xd5\x2a\xc3\xdd\x9d\x04\x4f\xb0\xd3\x11\x03\x1b\x58\x6b\xcf\x62\x9a\x01\xbc\xa1\x58\x33\x98\x2a\x84\x1b\x64\xd0\x0b\x3c\xae\xb9\x5a\x1a\x8f\xc4\xd2\x1f\xb8\x31\xcb\xeb\xa1\x50\xf8\xc4\x01\xc5\x23\x50\xfe\xb9\x47\x40\x3c\x01\x5c\xe9\x98\x33\xbd\xee\x6e\x84\x12\x0e\x60\xc5\x1d\x3e\x65\x4f\xcd\xf3\xc3\x87\x54\x86\xe2\x46\xe1\x7b\x51\x85\x73\x6c\x18\xbf\x66\x00\x7d\x3f\x61\xcb\xd4\xd7\x2d\x10\xdb\x66\xd1\x04\x0c\xb7\x4c\x5a\x26\x4a\xca\x4d\x2f\x66\x58\xe3\x7a\x17\x7e\xc0\x56\x21\x04\x5c\x35\xd1\x32\xc5\x61\x1d\x51\xab\xb4\xbb\x02\xff\x2b\x01\xa6\x84\xd7\x04\x9a\x1f\xcf\xf6\xe0\xbc\xc3\xab\xba\x12\x51\xec\xec\x07\xdb\x40\x7a\xda\xd6\xcb\x28\xfe\x1c\x5a\x04\x4a\x57\x79\x79\xd4\x9b\x13\x49\x4e\x00\x33\xaa\xae\x75\xb5\x9d\x0f\x7a\xfa\x0e\x09\x43\x92\xeb\x89\x44\x02\x33\xc3\x59\xbe\xff\x28\x61\xf9\xb9\x99\x8a\xfd\xc9\x44\x03\xca\xb7\x49\x8b\x4f\x1b\x50\xee\x71\x47\x3d\xd2\xe6\x15\xd0\x75\x99\x5a\x0d\x6d\x85\x42\x92\x38\x40\x38\x95\x81\xb2\xbb\xcb\x22\x64\x2c\x16\xd5\x09\x13\x7e\xff\x9d\xe5\xec\x19\x73\xaf\xe3\xa7\x4c\x06\xa2\x93\xb3\x07\x13\xf6\x28\x74\x5f\x79\x50\xc8\xf8\xce\xd9\xf7\xfe\x5b\xbf\x3a\x49\x93\xc3\xcf\x82\xa1\xa4\x0d\xd7\xa3\x0e\xc3\x0e\xbc\x62\xf4\x2a\xdf\x7f\xc4\x1e\xc2\x5e\x5b\x9e\xbe\x8f\xf2\xfd\x47\x0f\x0f\xcd\xa9\x15\xa9\x4e\xec\x08\xea\xee\x68\xf1\xca\xd9\x07\xa1\x79\x00\x92\x9f\xf1\x8a\x4d\xad\xf2\x49\x99\xb1\x17\xb4\x17\xd4\x86\xdf\x43\x23\x14\x81\xaf\x58\x53\x97\x25\xb2\xdd\x6a\x20\xb4\x0a\x12\xdf\x71\xfa\xba\x69\x5e\xf0\x1c\xb2\xf5\x84\x27\x4c\xf4\x1c\xa9\x44\x85\x93\x31\xec\xc0\xea\x3c\x2d\xe8\x12\x85\x56\x7a\x8a\x8f\xa6\x22\x70\x3c\x93\x84\xc9\x16\x93\x8c\xf9\x8a\xda\x44\xc5\x6a\x3d\x6d\x54\x29\x68\x06\x69\x75\xa1\x67\x26\x91\xc0\x79\x64\x73\x3a\x27\xf1\xab\x80\xfa\x09\x76\xf3\xc8\x47\xe4\x79\x86\x06\x8e\x02\x10\x0d\xe3\x64\xe0\x63\xc7\x06\xeb\x97\xe9\x11\x30\x3e\xea\xca\x86\x3d\x12\x35\x84\x00\xc6\xa2\xa6\x6e\x93\x11\x78\xd0\x6f\x85\xb2\x94\xa5\xaf\x5f\x7e\x78\xff\xfe\xe4\xf5\xe9\xeb\x8f\xfd\x66\x1c\x1d\xfa\xb2\x58\xf0\x52\x99\xd8\x4f\x7d\xe4\x0e\xac\x1b\xfc\x21\x94\x13\x0c\x05\xc1\xe9\x81\x81\x09\xe7\x5f\xc1\xea\xec\xda\x8c\x64\xe7\x59\x58\xd6\xaf\x4a\x84\x87\x29\x8f\xe6\x8b\xe1\x39\x44\x27\x3a\x31\xbb\x4e\xbc\xd1\x89\x7b\xd0\xe5\x1d\x01\x45\x4e\x84\x5a\x95\x2d\x11\x42\xeb\x48\xcc\x82\xf1\xd5\x40\x31\xd5\xd7\x49\x7d\xa9\x2c\x4c\x73\xd4\xd3\x1c\x6f\x23\x4b\xf5\x5a\x93\x89\x8e\xb9\x31\xd9\xb7\x7c\x43\x7b\xd9\x1c\x2f\x33\xd8\x18\xa0\xba\x7a\xb0\x7b\x9b\x4f\x6d\xc6\x31\xe8\x50\x7b\xa5\x1f\x3f\x5e\x79\xac\xe9\x9c\x96\xef\xb4\x0b\xdd\x86\x3d\x2d\x3d\xa6\xa6\x62\xb6\xaa\x80\x67\xf9\x34\xd1\x63\xf2\x52\x2a\xc1\xf2\x29\x8d\x18\x60\xcf\xb6\xf4\x06\x0e\x97\xd8\x89\x84\x8d\xb9\x47\x5d\xd1\x3f\xe2\x76\xcb\x49\xc0\xdd\x5d\x2d\x47\x69\x3e\x05\x67\x59\x3e\x65\xd7\x5d\x09\xd5\xdf\xdb\xab\x01\x5d\x39\x25\x0a\x7c\xbc\xea\x27\xf1\xfa\xd8\x13\x3b\x5b\x0a\x7a\x09\x78\x7c\xa0\x94\x33\x96\xa3\xf4\xa2\x43\x7e\x73\x32\xdc\xdb\x3b\xa7\x55\x21\xf3\xe7\x32\xfc\xa0\x7e\x2b\x3f\x80\x25\x61\x58\x44\x10\x2d\x9e\xaf\x0c\xb0\xa9\x98\xc9\x21\x66\x59\xa9\xb8\x7b\xb6\x83\x2d\xb9\x0a\x7a\x29\xa1\x5c\xeb\x61\xda\x01\x4b\x97\xc1\xbc\x3c\x8e\x4c\x1e\x6b\xea\xd4\xe2\x30\xc1\xc3\xd9\xc4\x1c\xd3\x4d\x24\x0b\xa9\xe8\x7e\x27\x4d\x64\x30\x05\xc5\x15\x95\x3b\xe5\xca\x0b\x01\x3b\x5e\x98\x6c\x6c\xc6\x65\x65\x51\x47\x88\x2e\x87\x95\x67\x41\x7c\x15\xfa\xb7\x65\xb1\xfa\x78\x75\xac\xcc\xae\x22\x58\xe5\x88\xac\xb4\xaf\x74\x7a\xa6\xba\xe8\x76\xcb\xa2\x6a\x6b\x47\xba\x28\x48\x82\x7d\xf7\x16\x5e\x7d\x25\xd2\x46\xb8\x26\x6c\x37\x90\xae\x6b\x0b\xfd\x48\x27\x8f\x0a\x8d\x3c\x5f\xeb\x98\x55\x41\x7f\x35\x4f\xf1\x93\xfa\x2c\xb0\xf1\x71\x48\x88\x4e\x5b\x2c\xc0\x26\xc4\xc5\x68\xe1\x8e\x76\xc3\x59\x10\x5e\xe5\xd0\x00\xd8\x0a\x30\xef\x43\xee\x0c\xab\x4f\xd2\x6e\x86\x2c\x9b\xa6\x8f\x94\x0a\x5b\x72\x05\x0b\x89\xb6\x06\x84\x60\x5d\x61\x8f\x75\xcb\xaa\x4b\x5c\xdc\x30\x95\x48\xf3\x0d\xe6\x74\x83\x28\x18\x27\xec\xb0\xea\xf3\xbb\x89\x6d\xb9\xc3\xe7\x75\x31\xdc\x5b\x05\xc3\xc0\x41\x81\x36\x3c\x02\xe9\x03\xe5\xae\x56\x8a\xc7\xc7\x8d\x2c\x26\xb7\xb9\x36\x40\xb9\x1a\x23\x2b\xd9\x62\x61\x81\x21\xd5\x33\x84\x12\x06\x7b\x1a\x4b\x38\x53\x3b\xb0\x3e\x04\xbc\x35\x3f\x8c\xb0\xb9\xe6\x87\xf5\xb5\x59\x54\x0d\x7c\xa4\x05\x96\x4b\x49\x63\xe4\x29\x5c\x79\x81\x27\xab\x76\xba\xd1\x1a\xde\x70\xe2\x7b\xd9\xba\xdd\x08\x84\xe2\x49\xeb\xb2\x8d\x76\xeb\x78\x30\xfd\x8c\x97\xff\xc4\x29\x92\x78\x74\xdb\x7e\x60\x67\x85\x73\xf3\xa7\xcd\x38\x36\xfd\x51\xbf\xb1\x02\x69\xea\x77\x01\x68\x41\xe3\x01\x2c\x5b\xeb\xb8\xa0\x0a\x46\xad\xa4\x7a\x09\x9c\x0c\x2e\x74\xfb\xed\x74\x2c\xd4\x84\x7d\xe3\xda\xa0\xd6\x09\x54\xc8\x19\xf8\xe3\x61\x33\x21\x7b\xcd\x7c\x6a\xaf\x2c\xc1\x6d\x27\xd3\x17\x30\xed\x7c\xbc\xd2\xe8\x59\x8d\xd2\x5b\x2b\x0f\xee\xc7\x12\xba\xf6\x34\x1a\x1c\x55\x19\x23\x40\x7d\x66\x05\x9b\x8b\x07\x16\xc6\x04\x14\x97\xe5\x46\x08\x3b\x39\x14\x1c\xc3\x12\xb6\x4b\xfc\xba\xce\xa7\x47\x8e\x3f\x09\x6b\xaf\x8e\x58\x7b\x75\x13\xc7\x4f\xb7\xe3\xd8\x5e\xa5\x27\x75\x59\xc2\x52\x25\x8a\xef\xbe\x74\x0f\xc8\x68\x2d\xef\xad\x9d\x7e\x89\xc5\x4d\xaf\xdb\xab\x54\xbf\x88\x68\x6d\x7f\x63\x96\x6c\x68\x7b\x1e\x57\x45\xed\xc7\x04\xfb\x4b\x35\x7b\x9c\x19\x86\xfb\xbc\xae\x2f\x4c\x28\xa9\xab\x19\xd8\x22\xd6\x7c\xf0\x8d\x91\x3b\x9d\x28\x46\xe7\x5a\x2f\x06\x36\xd1\xa9\x38\x71\x0b\x37\xc8\xc8\x69\x8e\x74\x68\xe7\x55\xa2\xf1\xa1\x7f\x28\x83\x24\x18\xf8\x60\xb0\x63\x73\xa7\x3f\xbf\x65\xac\x8b\xc3\x73\x30\xa5\x29\xcb\x28\xaf\xf4\x24\x7b\xda\xf2\xa6\xb5\xde\x8c\x14\x56\x48\xd6\x51\x46\x4b\x7e\x48\x6c\x79\xa9\xd7\xa6\x70\xd0\x14\x66\x06\xf0\xc2\xd1\xb2\x12\xf3\xdb\x21\x1e\x94\xd0\xd7\x38\x0a\x02\xb7\x01\xe5\x9c\xbd\x54\x2e\xd0\x0f\x9a\x30\xd9\x1e\xdc\x5a\x75\x43\x89\x36\x21\x3d\x54\x51\x58\xbf\x25\xfa\xdc\x55\xc2\x0e\x40\xe5\x0a\x1b\x7b\x66\x0f\x28\x0b\x2f\x5a\x17\x72\xef\xb1\x7a\x8a\x49\xa0\xd4\x40\x8e\x45\xbd\x69\x80\xfe\x42\x60\x33\xa5\x69\x84\x5d\xd7\xb6\x91\x99\xee\x28\x5f\xe5\xb2\xed\xa4\xcb\x6b\xc9\x83\x6c\x31\x7d\x81\x14\xc0\xbe\x77\xb3\xa6\x39\x91\x02\x68\x6e\x66\xf5\xa9\x85\x46\x2e\x22\xdb\x5d\x77\x79\x80\x87\xa7\x11\x09\x92\xf8\xc0\x0a\x65\x2f\x4f\x0a\x92\xdb\xb5\x75\x37\x20\x1e\xf9\x30\x75\x21\xe3\x39\x87\x49\xc4\x64\x48\xc2\xcc\x30\x1c\xbf\xd3\xb1\x6f\xfb\x01\x65\xe2\x42\x2e\x97\x22\xf7\xfa\xa5\xa1\x04\x03\x46\xf7\x6c\xeb\x1c\xf8\x25\x3d\x63\x5f\x00\x85\xba\x16\x30\xcc\x45\x73\x43\x8f\x68\x02\x8f\xe6\x0e\xf3\xf8\x8f\xf3\x81\x7a\x2d\x0b\x36\x4f\xa9\xeb\x93\xae\x9a\x24\x8d\x96\x0d\xad\xa8\x4c\x2d\x68\x5d\xb7\x66\xb8\xe4\x98\x7b\xa7\x9e\x7c\xa9\x2c\xf8\x88\x63\xdd\xbe\x7a\xa7\x0f\x3e\x6a\x03\x99\x4f\x50\x89\x76\xd3\x6e\xdb\x0f\xa8\x8a\x00\x4d\x3a\x1d\x46\xe9\x33\x75\x92\x62\x5d\x42\x56\xeb\xfa\x62\x60\x60\xe1\x50\x86\x14\x71\x30\x9c\xa9\xcf\x54\x3d\x9a\x83\xf9\x04\x80\x4c\x3f\x08\x0b\x3f\x27\x1e\xa6\xfa\xb2\x1f\x82\xbc\x5d\xf8\xd2\x05\x5e\xe2\x63\xc2\xe6\x98\x2d\x58\x53\x5f\x81\xd6\x24\xea\x23\x7e\xca\x97\x15\xb3\x18\xd1\x49\xa9\xa8\x1f\x14\x91\x58\x99\x73\x89\x8c\xc0\x7a\xe7\xce\x47\x14\x3c\x4d\xdc\x31\x60\x42\x5d\x02\x6f\x6c\xce\x32\x24\x0e\xcc\x26\xbe\x38\xc0\x9a\x60\x2d\x1a\x48\x1a\xdb\xe4\x36\x9d\x99\x43\x79\x58\x02\x72\x5e\x03\xc5\x14\x31\x24\xb1\xab\x5a\x7b\x40\xc8\xe2\x31\xe8\xa2\x39\x3b\xd7\x6e\x9f\x1e\x60\x40\x36\x6a\x60\x63\x0c\xd5\xbe\x7f\xf2\xbd\xcb\x1e\x3f\x9b\x1e\xb0\xd6\x1d\x8d\xd0\x28\xd1\xc1\x08\x2c\x1e\xe3\x11\x5b\x83\x35\xcd\xf4\x50\xc9\xf2\x8d\x43\x2a\x6b\x2c\x6b\xf3\x3c\xdb\x86\x02\x7e\xf7\x6b\x19\xb0\xb6\x22\x6d\xbd\xf3\xb2\xdc\x16\x55\xeb\x0c\x4c\xd7\x4d\xb0\x31\xaf\x6f\x7c\x0b\x19\xf4\x12\x1c\xb9\xb7\x23\xcd\xc1\x31\xb6\xc4\x91\x73\x71\x24\xf6\x23\x45\xe3\xc2\x6f\x3a\x9b\x63\xbe\x0c\x1e\xd4\xb7\xf5\x4e\x7f\x7e\x7b\x44\x3f\x91\x67\xae\x1e\x18\x03\xf4\x09\xf8\xe7\x3e\xa0\x45\x40\x4d\xc1\x14\xfe\xbe\xbe\x8c\xe2\xc4\xeb\x04\xad\x36\x80\x96\x6e\xb9\xc1\x83\xd8\x39\x90\xb0\x09\x4a\x38\x29\x30\x2b\x78\xa1\xaa\x18\xa4\xdf\xb0\xac\x78\xd0\x01\x82\xb5\x2c\x18\xed\xef\x9c\xca\x2a\x83\xa3\xc8\x45\x9d\x62\x0f\xe2\xae\xb9\x3b\x68\x9f\x62\x5b\x13\xda\xd4\x75\x38\xd9\x56\xd0\xf2\x99\x60\xb1\xf0\xc3\x6b\x93\xfd\xd3\xbe\xb6\xbb\x1f\x56\x50\xf6\xf4\x46\x08\x9c\x87\x7e\xca\xe4\xde\x5e\xa7\x6d\x5e\x96\x67\xf2\x3c\x0d\x55\xb3\x4f\x1f\x87\x8f\x55\xaa\x2e\x0d\xb1\xd6\xa8\x1f\xaa\x4c\xa0\x46\x32\xf9\x85\x21\xd1\x70\xc7\xe8\x71\x2b\x7d\x4a\x88\x4c\x7a\xc5\x5b\xce\xd2\xc9\x37\x37\xe4\x65\xe1\xe7\xd9\x06\x73\xaf\xae\xcc\x11\x27\xd3\xd4\x97\xce\x24\x83\x0b\x4e\x28\x9e\x1a\xd1\x8f\x9f\xfa\x3b\x83\xbb\xbb\x2e\x87\x73\x8f\x79\xe6\x0b\xfc\xd3\x97\xab\x1b\x43\x13\xa4\x94\x9f\x26\x1c\x54\x28\xa4\x79\xee\x78\x43\xc8\x6a\x31\x35\x02\x9b\x85\x50\xa0\x86\xfd\xf4\xda\xf8\x39\x48\x1a\xdd\xb1\x7a\x6f\x46\x76\x32\x7e\x40\xb0\x63\x46\x18\xdf\x99\x74\xb0\xca\x25\xcf\x15\x36\x0d\x3f\xed\x07\xc8\xf2\xe2\x99\xf8\xfd\xad\x12\x2b\xad\x9a\x84\x6e\xc3\x01\x41\x26\x98\xd1\x66\xe2\x41\xa6\x74\x28\xe3\x9c\xd7\x9e\x08\xc1\x1e\x96\xc8\xbd\x83\xd2\xf3\x34\xec\x37\x6d\xc7\x85\x43\xf3\xfb\x49\xb7\xdc\x67\x9b\xff\x27\x6f\x2a\x6a\xdd\xa5\x52\x1f\xdf\x96\x23\x83\x58\x4e\x42\xf6\xcd\xdc\x08\xc6\x6b\x8c\x23\xc8\x81\xd4\x30\x6d\xa0\x9b\xef\x7a\x7b\x5d\xde\xb6\x36\x02\x15\xb0\x79\xde\xb6\x8d\x2b\x8e\xf4\xa1\x90\xda\x71\x3e\x1d\x27\x2c\x14\xdc\x64\xb8\x24\xea\x6a\x53\x18\x75\xf8\xb6\x92\x96\xd4\xa6\xb4\xd5\xeb\xdd\x1a\x86\xba\xd1\x38\x5f\x85\x55\x5e\xad\x86\x6b\x1c\x83\x2e\x8d\xc6\xa0\xc4\x4c\x51\xd0\x6b\xdb\x50\x81\x0b\x6e\x20\x3c\x20\xe7\x59\x7b\xfa\xf3\x5b\xd2\xa9\x3f\xbf\xa5\xaa\x30\x71\xc4\xdb\xea\xc2\x2d\x3c\xa2\x81\x30\x4a\xfc\xf1\xb6\xce\x10\xc3\xc8\x54\xb0\x7c\xea\x88\x25\x49\xac\x63\x84\x9b\x8f\x81\x2f\x89\x6e\xe5\x79\xb5\x89\xc6\x38\x1d\x98\x7e\xbc\x6e\x1a\xb3\xc8\xc7\xbf\x2d\xf7\xdf\xd6\x33\xe0\xa0\xf2\xd8\x8f\xb2\x9e\x40\x98\x42\xa3\x3c\x5b\xce\x76\x14\xe6\x1c\xd1\x2c\xc1\x75\x0b\x7e\x01\x58\x2c\x63\x86\x20\xd0\x9c\x7f\x67\xcb\x92\x67\x02\xf2\xc2\xd3\xa1\x32\x9c\x4e\x19\x78\x0e\x65\x8e\x21\x6f\xbf\xad\xea\x16\x4e\x2c\xee\xef\x33\x9d\x08\x47\x25\xb8\x89\x29\x78\xa5\x12\xd4\x0b\x7a\x69\x09\x91\xe0\xb8\x6d\x7a\x21\x96\x2d\x6d\xf8\x50\x24\x0b\xc5\xa4\x60\x53\x68\xf1\xde\xff\xfb\x7d\x58\x74\xcd\xa1\x09\x7d\x3e\x02\x95\x46\x65\xfc\x8d\x8e\x49\xdb\x2d\x32\xf2\x37\x38\x5d\x32\xa5\x57\x2a\x7d\xa1\x4f\xba\xd8\x2f\xd8\x05\x36\xdd\xb4\xfa\x18\x13\x86\x4c\x1d\x99\xd9\xd1\xce\x6f\x07\x30\xa9\x3d\x43\x7b\x08\x5b\xed\xed\xdc\x67\x73\x18\x47\xf8\xed\x4c\xba\xa3\x5d\x81\x6e\xb2\x8a\x44\xb7\x09\xc1\xa9\x6e\x60\x93\x8c\x64\x73\x98\x0a\xee\xff\xdb\xbf\xdd\x07\x2f\xa1\x7c\x78\x18\xb4\xea\x01\x32\x7f\xa6\x29\x9c\x6a\x17\x98\x94\x24\x9b\xc7\xa3\xce\x67\x40\xb3\xf7\x0e\x1a\xe9\xe3\x0a\x7f\x6e\x98\x28\x95\x70\x88\x68\x4c\xfb\xad\xea\xf7\xbe\x11\xe1\xe4\xd1\x76\xd3\xf4\xe5\xfe\x7d\x88\x78\xa1\xa7\xb1\xff\xf0\xbf\xef\x1f\x8d\x86\xc0\x66\xf3\x41\x48\x7f\x47\xa2\x20\x8b\x34\x55\x80\xe7\xde\x7e\xb2\x47\x0f\x1a\x9c\x5a\x58\xb4\x93\x0f\x0a\x9f\x41\xe5\xf3\x38\x0e\xaa\xc0\xbb\x87\x0f\xef\x7a\xb4\x6d\x88\xe0\x81\x55\x37\x35\x9a\x01\xd7\xad\x9e\xcc\x5a\x34\x58\x5f\x48\x7b\x47\x5c\x78\x33\xfb\xcc\x01\x17\xa8\xb2\xe5\x7c\x4b\x60\x53\x84\xdc\x83\xe6\x27\x6c\xdd\x33\xb6\x3c\x69\x5d\xdb\xf6\xc1\x40\x30\x33\x08\xb2\x34\x98\x4d\xa9\xbf\x63\x18\xdd\xde\x34\x09\x0a\xa0\x57\x28\x8c\xc9\x85\x94\xb4\xe6\x74\x0c\xd6\xb1\x8e\xb8\x3e\xf4\xfb\x70\x0a\xdb\x54\x8c\xc6\x8f\x0e\x0e\x9e\xec\x1d\x1c\xee\x1d\x3c\x62\x87\xdf\x1d\x1d\x3c\x3e\x3a\xf8\x2e\xfd\xaf\xf8\x9f\xce\x19\x77\xdf\xc3\x04\x83\xa7\x75\xcc\x34\x85\x4a\x53\x84\x34\x59\xda\x70\xd0\x36\xc1\x58\xf6\xbf\xe9\x7f\x0e\x9f\xe8\x7f\x21\x8a\x7a\x45\x85\x8a\xb2\xe6\x58\x09\x7f\x3c\x79\xdc\xc3\xd0\x85\x37\x47\xeb\x01\x71\x18\xdf\xff\xfb\xfd\x31\xe9\xdd\x70\x86\xa0\x12\x74\x93\x99\x2c\xc5\x51\x29\x2b\x1b\x4a\xac\x83\x47\x75\x0d\x5f\xe7\xb6\x70\x03\x1e\x5d\x6d\x47\x2a\xb1\x3b\xf1\x84\xa2\xb5\xcc\xfc\x03\xf7\xd0\xad\x65\xdb\x24\xec\xdb\x47\x1a\x59\x1d\x15\x0e\x21\x7a\x0b\x91\xbe\x44\x48\x2a\x7a\x94\xb0\x65\x46\x89\xf8\x8b\x86\x2f\x84\x1a\x28\xf5\x06\x3f\x44\xcb\x4c\x9d\x1d\x55\xe7\xba\xf0\xf2\x02\xb3\x16\x12\x7e\x3f\xf1\x76\x4e\x0b\xce\x22\xd8\x33\x40\x98\x09\x5b\x40\x90\x0e\xc4\xca\xc1\x23\x5c\x68\x71\xd5\x09\x4c\xff\xc6\xa8\xed\x1f\xb8\xfa\xa9\x11\x90\x6f\x0a\xab\xa6\x6f\xc8\x1d\x90\xb0\xe5\xc5\xec\xe1\x38\x1d\xe3\x99\xa6\x3b\x14\x1f\x9b\x4e\x8c\x7d\xd3\xc8\x67\xa7\xae\x00\xc1\x42\x70\xc4\x9f\x4e\xf8\xc3\xbd\x7c\xe9\x71\x5b\x73\x02\xf8\x56\x56\xb4\x5d\xe4\x18\x6e\x70\xc6\x5e\x0d\xc2\x1e\x8f\x7b\xe3\x4e\x0b\x86\x47\x2f\x2a\x4b\x5b\xbe\xfa\xba\xc2\x25\xbc\x1f\xe6\xbd\x57\xb3\xcb\x78\x18\xbd\x6c\xc1\x9b\x0b\x61\xf2\x40\xd0\xc2\x9c\xb0\x31\xe7\x04\x3e\x6e\x96\xe2\x43\x11\xe9\x92\xb0\x5d\xf5\xd3\xc5\x8c\x38\x67\x22\x4d\xcc\x45\x49\xc1\x22\x21\x9f\x9a\xe5\x81\x8b\x0c\xa1\x1b\xb2\x98\x97\x3f\xd8\xc8\xbe\x5e\xfd\x80\xd7\x98\x9b\x3d\x5e\x7f\x7b\x11\x03\x76\x70\xd9\x56\xe3\x36\x1f\x2f\x3b\x57\x76\x51\x96\x01\x02\x13\x60\x62\xc0\x05\xa8\x98\x8a\xec\xec\xfc\x01\xfd\xee\x06\x4e\x78\xd7\x50\xb9\xd9\x1f\x7e\x10\xfa\x9f\xdc\x49\x7c\xd5\xd6\x4b\x9a\x3c\x79\x15\x92\xf3\x72\x46\x3c\xc5\xe5\x29\x04\x5d\xfd\xa3\xa9\x57\xcb\x70\x21\x65\xd6\x42\xb0\x05\x00\x6b\xcb\xe9\xc6\x5b\x6d\x26\xa8\xa8\xc1\x44\x6c\x4d\xfc\x2b\x78\xd6\x5d\x46\xfd\x46\xd9\x6b\xd2\x62\x73\x13\xda\x74\xa3\xbf\x91\x5f\xbf\xad\xd9\xaa\x32\x91\x1a\x28\x3c\xc6\xb9\xdf\x5b\xfb\x2a\x3b\x31\x75\x6f\xe1\xf8\xec\xb5\x21\x67\xe7\xf6\xd6\x10\x77\xd9\xd1\x80\xcf\x02\x5d\x3e\x74\x9f\xd2\xf5\xc0\x4d\x57\x47\xb7\xdc\x82\x78\xf3\x07\x36\x78\x75\x1f\x6b\x42\x09\xd4\x1e\x86\xa9\xbd\x17\x97\x74\xeb\x5e\x8d\xb7\xdd\xc4\xa3\xdb\xbc\x24\xbd\x85\x52\x06\x17\x2a\x51\x67\x5d\x31\x92\xba\x23\x08\xc1\x4e\x3f\x2c\x45\xf5\xea\x45\x64\x11\xf0\x56\x0b\x7a\x47\xf6\xc8\xc5\x7c\x78\x0b\x89\xb6\x5e\xa2\xdf\x09\xd3\xa1\x04\xa2\x35\xe4\x7f\x22\x31\x7e\x59\xcc\x3c\x9a\xd8\x0b\xde\xbc\x1e\x48\x7b\xb7\xd9\xcb\xa1\x7b\x5c\xb6\xdf\x8f\xb0\xed\xca\x0c\x82\xc6\x6e\xbf\xef\xc5\xe9\xc1\x0e\x23\x06\xf9\xe0\x30\xbc\x9b\xe3\xea\x4e\x18\x0f\xe0\x82\x72\xb0\x4b\xad\xe1\x8e\xee\x20\xc3\xbc\x0a\x29\xa9\xb1\x5e\x8e\x73\xf8\x3f\x73\x04\xb7\x2b\x36\xf7\x2e\x61\x4d\xdc\x67\x1c\x5e\x19\xe6\xf3\x8c\x6e\x15\xbb\xee\x76\x7c\xc2\xf0\x4b\x44\x57\x61\xc4\x4f\xff\x5c\x72\xd8\xb5\x28\x58\xcf\x0e\xe9\xd8\xf8\x32\x86\x6f\xa5\xfb\x3e\x70\xfe\x66\xe9\xe5\x2c\x7d\x9e\xe7\xd1\xa1\x6b\x79\x56\xb3\xcc\xaf\x1a\x0d\x02\xf2\x09\x43\x63\xcc\xac\x47\x79\xee\x85\x0f\x72\x3b\x8d\x10\x82\xf6\x0c\x80\x51\xf4\x3a\x0c\xa7\x11\x5a\x5b\x5a\x37\x5d\xe4\x5f\xc0\xa6\x81\x46\xb1\x99\x14\xa8\x0b\xb0\x1c\x34\xe0\x3b\x33\x83\x19\x65\x8e\x51\x8e\x44\x1e\x01\x60\x70\x51\xf7\x36\x94\xd5\xdf\xfb\xea\xcf\x82\x56\x3e\xe8\x85\x15\x8e\x2d\x1c\xa1\x62\x5b\xfd\xed\x26\x10\x21\xac\x99\x91\x5a\x81\x5a\xdd\x0b\x46\x3d\x20\x53\xa1\x30\x56\x86\x1a\x39\x3b\x70\x4b\xbf\x7e\xc7\x4d\xa1\xc3\xa3\x73\x0f\x04\x35\xd8\xa4\x39\x2c\x72\x78\xab\xa2\x38\x3d\xae\xe0\x8e\x99\x67\x08\xbe\xff\x3e\xac\x6b\xd1\x98\x30\x27\x99\xae\x2f\xe1\x2f\xea\x34\xc1\xf5\xba\x4c\x1f\x0c\x8a\x59\x0a\x0b\x38\x92\xc8\x7b\x74\x32\xd5\xa7\x66\x7c\x9e\xda\x30\x4d\x4f\x2a\xe1\x26\x10\xf2\x1b\x5b\x26\x2f\x45\x23\xeb\x1c\x72\x85\x96\x1b\x3a\xb6\x02\x33\x29\xc9\x14\x48\x1b\x8e\xb9\x7c\x48\xde\x3c\xd0\x5b\x2f\x89\x24\xc3\x09\xf7\xe1\x70\x1c\xe9\x33\x16\xf8\xb6\x95\xd9\x45\xf7\xf0\x06\xbc\xb1\xc0\xfc\x4d\x3c\x5d\xd8\x3f\x88\x11\x1a\xd8\x5b\xcf\x75\xa4\x60\xc8\xb8\x35\x8c\xa3\x67\xb7\x24\xb5\xd0\x3b\xb7\xf1\x05\x03\x85\xb6\x45\xc0\x33\x56\x65\x02\xf7\xb6\x8c\x03\x18\x6c\x1f\x0a\x4d\xb7\xdb\x67\x2f\x78\x76\x31\x6b\x20\xab\x7c\x14\x27\x2c\xec\xb5\xf9\xcf\x0d\x3c\xad\x9a\x51\x14\x7f\x92\xd5\x8c\x3c\xca\xe0\xfb\x8a\x69\xc2\x0b\x6b\x6a\x1c\xa2\xb8\xd3\x9d\x1b\x6b\x0b\x05\xcc\x24\xd5\x4a\x9d\xa1\xfe\xe2\xcb\xa0\xd3\xc6\x38\xf3\xe8\x8c\x6f\xa2\xd8\xb7\x1e\xe0\x55\xa4\x29\x4f\x6b\x5e\x60\x3d\x18\x8a\x51\xec\xc3\xff\x1c\x3d\xb1\xb3\x74\x47\x93\x0f\xdf\xa8\x05\xfb\xed\x66\x34\x1a\xba\xb8\xbc\x77\x3b\x07\x7e\xfc\x51\x6c\x4e\x28\x8f\x43\x70\x86\x02\x33\x75\x86\xa1\x42\x10\xb1\x19\xa4\xca\xc5\xfb\x70\xaa\x1a\xae\xfb\x6e\x72\x38\x60\x67\x8e\xc3\xa2\xd7\x2e\xa7\xf4\x60\x14\xb6\xd2\x6b\x6d\x12\x98\x18\x0e\x86\x9f\x81\x28\x1e\x40\x57\x47\x06\x0d\x63\x4b\x47\xee\xea\xa2\x87\x2a\x58\x57\x33\x77\x3d\xb9\xc6\x37\x31\xe0\x29\xa9\x6e\xbd\x2a\x73\xb6\xa8\xe9\xca\x34\x13\xab\x03\x41\x20\x95\xf6\x5a\x22\xcc\x5e\x97\x0c\x46\xdb\x7a\xe4\x92\x62\x68\xfc\x72\x7b\xbf\x04\xf6\x09\x8c\x35\x38\x7e\x07\xf1\x0c\xbc\x93\xae\x78\xa0\x2b\xb4\x73\xa3\xab\x06\x0b\x21\xe3\x9c\x1f\x8c\xcd\xa2\x77\x37\xae\xdd\x13\xa1\xea\x12\x8e\xf4\x35\xfa\x07\x2d\x3a\x4d\xfa\x6c\x47\x29\x87\x83\xc7\x64\x7d\x17\x70\xa5\xdf\x9b\xf5\x59\x08\xb7\x1b\xe5\x43\x1f\x22\x00\x82\xb1\x5f\x10\xe5\xda\xc6\x0c\x74\x9c\xd9\x3e\x26\x4d\xfd\xae\xce\x57\x65\xdd\xc7\xd0\xa4\x32\xbb\x10\x1b\x3c\xef\x0c\xa0\xee\xb1\x0a\xae\x3c\x9a\xf1\x56\xae\x85\xfd\xa2\xbd\xc7\xe1\xc9\x49\xc2\xb2\x03\xdc\x2e\xe7\x80\x32\xf4\xd6\x8f\x31\x09\x3a\x65\x74\x7f\x08\x23\xbe\x53\xdf\x88\x0c\xce\x6f\xa7\x8c\x00\xc1\x29\xf5\x0b\x41\x49\x48\xb7\x59\xc1\x34\xf3\x1d\x38\xd3\x2e\x98\x11\x01\xc6\x9a\xdd\x73\xd9\x19\xe2\xc4\x3b\x6d\xfe\x03\x57\xf3\x3e\x39\x91\x58\xc0\xde\x6a\x83\x99\x89\x4c\xd0\xc9\x9b\xf7\xbf\xec\x1d\x72\x18\xdf\xe4\x79\x00\x5a\x92\x7b\xa1\xa8\x9b\x05\x11\x32\x00\xfa\x55\x64\xf4\x21\x7c\x11\x11\xd1\xe3\x5e\x54\x6b\x18\x6b\x4f\x1e\x73\xa3\x4a\x17\x6d\xfa\x06\xf3\x47\x44\xf3\x84\x59\x8a\x7a\x14\x9a\xa7\xa7\xab\xc5\x93\xc7\x51\xbc\x95\x52\x27\xa0\x28\xfa\xa4\xea\x4a\x1e\xce\x7c\x2a\x61\x2f\x60\xca\x52\x67\xf2\xdc\x1c\xb9\x11\x57\x30\x8f\x80\x28\xae\x96\x4b\xd1\xb0\x29\x14\x00\x2a\x22\xb7\x99\xc4\x0d\x93\x1f\x81\xf0\x36\xb1\x68\xc9\xe1\x8e\x2d\x2c\x37\x15\x25\x8c\x2b\xda\x85\x01\xdb\x46\x8f\x30\x5a\xe7\xeb\xd6\xd8\xf5\xe1\xc1\xc1\x41\xc2\x1e\x1d\x1c\x1c\xdc\xe0\xfc\xf1\x6d\x38\x0e\xc3\x3e\x04\x4a\x82\x20\x9c\x9d\x63\xe7\x47\x5f\xc6\xae\x26\x84\xfc\x07\xc5\xfe\xf8\x8f\x48\x3d\xf4\x5a\x26\x44\x35\x3b\x73\x36\xa9\xa1\x90\x05\x00\x9e\x72\xf6\x8c\x0a\xba\xd7\xbe\x5c\x24\x41\x16\x9c\x01\xd3\xdc\x80\x8d\xd9\x33\x56\xf5\x91\x0b\x8a\x38\x60\xc1\xf0\x3c\x08\xaf\x83\x74\xea\xf4\xde\x9a\x99\x34\x42\x20\x3e\x28\x57\x1a\x5b\xc8\x60\x8c\xf4\xa1\x84\x11\xbc\x15\x9f\xe0\x52\xc8\x30\x51\x83\xb6\x5a\x41\xc0\xc0\xdc\x64\x59\xbd\xc6\xb3\x3a\x78\xf6\x9d\xe6\x2b\x1c\xac\xb6\x7a\x2f\xf5\xbb\xf9\xf2\x8a\x6f\x5c\x23\x5e\x9e\x76\xf3\xee\x5d\x5d\xb5\xf3\xe0\xcd\xff\x14\xbc\xa1\xa9\x0c\x5e\xf5\x47\x8d\x75\xed\xfb\x7a\x99\x50\x56\x4c\x94\x7c\x09\x27\x30\x14\x84\xb2\x30\x8c\x62\x21\x39\xaf\x2b\x33\xf9\xc0\x10\x5a\x40\xc3\x5e\x37\x86\x25\x1b\xeb\x77\xa2\x7a\x01\x49\x87\xee\x17\x4b\xbb\xdf\xd8\x17\x09\x7b\x6b\x76\x72\x20\xed\x40\x64\x71\x8a\x47\x83\x29\x1f\xef\x20\x22\xe4\x18\xe4\xae\x7f\x46\x38\x9c\xa4\x01\xd3\x20\xe9\x50\xd4\xc0\xfa\xac\x69\x53\xb7\x29\x10\xdb\xd5\x2f\xde\x31\x00\x78\xfb\xe1\x13\x4d\x0a\xe4\x21\x8c\x70\xf7\xc4\x90\xec\x15\xf7\x92\xc2\x63\x48\x9d\x5d\xa3\x40\x11\xdb\x14\x48\x02\x18\xee\xe6\x19\x65\xc5\x7f\xf1\x8a\x6f\xe0\xf1\xc0\xfb\x7f\x1b\x9a\xe1\x25\x0a\x6d\xd4\xa6\xa7\xab\x69\x84\x8d\xc7\x6c\x9f\x45\x8f\x1e\xb3\x07\x9a\x0e\x3f\xd4\x2b\xb3\x1d\x4e\x84\x6d\x4d\x00\x29\x15\x77\x44\xf6\xa1\xee\x1d\xda\xd7\x37\xfd\x3e\x23\xee\x47\xa3\x6e\xa5\xc8\xf4\x72\xcf\x20\xae\x1f\xe3\x07\x87\x70\x64\x5a\x63\x4a\xfd\x8e\xd9\x1e\xd0\x38\xea\x90\x23\xee\x37\x06\x30\xfa\x6d\x19\xd8\x6c\xcf\x12\x50\xbf\xb8\x2d\x30\xa4\x2b\x40\x26\x4f\x38\xd8\x9c\x90\x06\xa3\xd5\xf9\x76\x34\xb3\x7d\xc1\x91\x05\x35\xfc\x4c\x1f\x54\xd7\x0f\xdf\x4f\x58\xf5\xc5\x42\x0a\xb7\xab\x91\xf1\x88\xcd\xa2\x3a\xeb\x8b\x6a\x78\x0b\x86\x9b\x7c\xbd\xa9\x01\xd6\x10\x6b\x01\x87\xcc\x79\x65\x67\x5f\x48\xa3\xb9\x5a\x88\x46\x66\xc6\x1c\x71\x08\xb4\x35\x14\x7b\xf2\x98\x86\x6f\x67\x96\x01\x1b\x27\x66\xdd\x58\xc5\x5b\x52\x53\x5a\x8c\xef\x96\xb1\xef\xcf\x49\x11\x46\x74\x31\x49\xc2\xdc\x5c\xf2\xd7\x25\x86\xc2\xf9\xd1\xcf\x0d\xf5\x14\xe6\xcb\x09\x3b\x7c\xf6\xec\xc9\xb7\x7b\x87\xd4\xdb\x0e\x82\x48\xe8\x68\xed\x21\xe8\x78\x7b\x6b\x92\xbf\x60\xdf\xda\x6c\xad\xfd\xc4\x1b\x25\x80\x4f\x5e\xf6\x3f\xc8\xd9\x94\xb0\x27\x8f\xc3\x10\xb9\x41\x64\xd6\x43\x58\xdc\x8c\xbe\x50\xb3\x5a\x21\x33\xd2\x1a\x4a\xe4\x27\xf9\x75\x22\x69\x76\x92\xc3\x05\x4a\x5b\x6f\x59\xa0\x04\xa2\xfb\x49\x06\xb2\xbb\xfa\x8b\x84\xf7\x4f\x15\x27\xa2\xb8\x95\x26\xc7\x9b\xaf\x94\x8b\x4f\xf2\xaf\x10\x0c\xaf\xb1\x50\x4f\x7c\x9d\x35\x4a\x46\xe6\x80\xdb\x96\x16\x19\x7b\xd1\x1a\xd2\xf2\xc4\x31\xfc\xed\xd0\xba\x19\xf5\x8b\x9a\x51\x75\x83\x6e\x1c\x51\xe5\xe8\xbd\xa1\x8b\x43\xf9\x85\xf8\x54\xa9\xd5\x12\x76\x86\x3b\x4e\x10\x32\xae\x0a\x7e\x21\xc0\xa5\x50\x2b\xd9\xd6\x0d\x5d\x45\x12\x06\xc8\xd2\x05\x6f\xe4\x97\x10\x20\x7a\x70\x20\xd1\x5c\xa9\xd2\x6d\x24\xf4\x6b\x38\x8f\x10\x8d\x1c\x57\x70\x10\x83\x0d\x5d\x50\x01\x78\x61\xfa\x38\x0c\x53\xe9\x84\x3f\x88\x32\x37\xd3\x86\xb9\xb0\xa5\x72\xf7\x29\x6c\xd8\x92\x32\xf4\x52\x0b\xf9\x94\xb5\x7c\x46\xa3\x25\x04\x1c\x51\x0d\x34\xd0\x08\x96\x39\x68\x1e\x19\xe9\x43\x14\x12\x4a\xbc\x71\xdb\x38\xd2\xc0\x62\x3f\xb5\x70\xd3\x52\x26\xbd\x8f\x9b\xa5\x71\xc5\x75\x43\xc5\x9a\x16\xee\x86\x43\xa4\xa2\x5e\xb4\x98\x2c\xc2\x9b\xb4\x96\x90\x46\xd8\xbc\xd1\xf9\x3c\x9b\x56\xdf\x72\x10\xc9\x38\xfd\xc8\x67\xe9\x3f\x44\x8b\x51\x98\xb1\xce\xf3\x79\x76\x70\x1e\x83\xe0\x53\xf7\x1c\x68\x4f\x98\x9a\xb5\x05\x91\xb8\x5c\x1e\x5b\xf4\x63\xd0\x6d\x38\x02\xe9\x67\xde\xb0\xf4\x0d\x13\x5f\xf7\x33\xdf\x0d\xf3\xcc\x6e\x6f\x7b\x7b\x36\x4c\xad\xb2\x39\xd5\xea\x72\xf1\x36\x06\xc2\x5d\x41\xe4\x94\x85\xa2\xc6\xc8\x1e\x94\x00\x53\xf9\x76\x9b\x7b\x70\x20\x62\xd2\xef\x20\xc7\x33\xcd\x05\xa2\x7d\x63\x9a\x72\x27\xf3\x6f\xeb\x3c\xcc\x00\xb0\x13\x50\xca\x0b\xe1\xdf\x26\xa9\x5d\x8d\x0a\xbc\xd8\x18\x23\xa9\x32\x98\x51\x4c\x94\xa3\xdf\xce\x76\x72\x60\x56\x7b\x3d\x35\xf8\xa7\x72\xff\x2c\xca\xf8\x13\x26\x5d\xa7\x6a\xda\xbf\xf7\xdb\x38\x84\x11\x50\x8f\x2b\x25\x67\xd5\x1b\xd8\xd3\x26\x5c\x70\xfb\xc2\x78\xda\xbb\x9f\xc3\x31\xb7\xa5\x53\xa0\x5f\x79\xd9\x57\xf6\xd8\x40\x7a\x2a\xda\xff\x25\x9a\x3a\x8a\xbb\x7d\x08\xb9\x3b\x38\xc2\xed\x26\x03\x34\x41\x63\x3a\x7d\x8e\x38\x42\xf8\xf2\xc7\x5a\x63\x49\x5f\xe2\xa1\xb6\xa3\xf5\x67\x1a\x36\x09\xf1\x6f\x49\x6e\xee\xc0\x8a\x52\x2c\x7c\x44\xc1\x3d\xee\xa3\x40\xba\xc8\x35\x49\x73\xd5\xd1\xc4\x27\x2d\x40\xa1\x92\x48\xd2\xf8\xe9\xf0\x84\xe6\xe1\x6c\x66\x34\x87\x77\xd8\x4b\x00\xf9\xf9\x8e\x82\x24\x57\x2e\x92\x51\x57\x7f\x9e\xe7\x4d\x14\xfb\x23\x2a\xc5\x74\x39\xa7\xba\xf0\x50\x70\x63\x37\xe5\xf7\x67\xe2\x21\xcd\xf2\xd6\xc6\xa2\x04\x5f\x34\x40\x13\x8d\x30\x14\x2e\x39\x48\x98\x2d\xc4\x09\x09\x74\x33\xea\x14\x25\x0a\x60\xe7\x9c\x78\x11\x81\x14\xac\x6c\x60\x60\xc2\xc1\xa9\x0b\xcb\x64\x10\x8c\x6d\xc7\xf3\x2f\xd8\xf7\x4e\x1a\xa0\xfa\xee\x2e\xbb\x60\xcf\xdc\x3b\x2f\x50\xc9\x5e\x8c\xf8\x52\x5b\xaa\x10\x0f\x46\x86\x2a\x1a\x9d\x34\xcd\xd0\x56\xc7\x06\x98\x03\xe1\x44\x42\xf5\x86\x80\x01\x30\x34\x06\x20\xd8\x2e\xda\x26\xd1\xda\x4e\xc3\x00\x66\xe8\x6c\x64\x2c\xcf\x6d\x63\xc7\xb4\x14\xb6\xf1\x19\x41\x1b\xd0\x51\x64\xcd\x68\xf5\xc2\xee\x7d\x84\xee\xda\x0b\x6c\xc0\xb6\x00\x27\x18\xde\x0a\x86\xa2\x10\xb4\xe6\xcd\x74\xaf\xe1\x2a\xc9\x7e\x7e\x33\x26\xe0\xbd\x62\xd3\xc4\x58\x39\x0b\xd1\xce\xeb\x9c\xf1\x14\x6b\x44\xd3\x18\xc8\x87\x5a\x5a\x7b\xb0\x0a\xe7\xaa\x41\x15\x9f\x8b\x4c\x2e\x78\x99\xbe\xa2\x7f\xdd\xb4\xa7\x01\xf0\x84\x4d\xb5\x36\xf7\xc4\x80\x0f\xea\x2c\x6e\x35\x16\xf7\xd3\xbc\x23\x5b\xf8\x7a\x80\x27\x46\xcb\xec\xee\x32\xee\x25\x82\x77\xfc\x90\x05\x43\xa5\xc3\xd7\xe9\x3b\xec\xd7\x8b\xcd\x7b\xbe\x10\xd1\x18\x71\x1b\xc7\x4f\xd9\xf6\x6b\x03\x16\x28\xd0\x0b\xa2\x65\xf0\x09\xc0\xa2\x29\x74\x5c\x69\x84\x0e\x41\x2e\xf4\xab\x0f\xab\x36\x7c\x07\x2f\x0e\xe2\x01\xec\x21\x60\x18\xea\x75\x42\x16\xa7\x58\x68\x01\x63\x22\x3a\xe8\x22\xe5\x09\xc9\x02\x43\x56\xa3\xb3\x73\x53\x1f\xe7\xc2\xeb\xe0\x09\xc1\xdd\xc4\x67\x07\xe7\x18\xb5\x18\xc5\xb7\x0e\xf6\x40\x06\x0d\x9c\x57\x42\x2c\x1d\x27\x8d\xc9\x00\xfc\x3d\x56\x70\x8d\x47\x4f\xa6\xd6\x60\xbd\xa3\x09\x90\x33\xae\xf0\x8e\x23\xcf\x16\xd2\x95\xa2\x75\x4f\x24\x40\x97\xf5\x27\x42\x42\xc6\x1a\x7a\x56\x1f\x0f\xdb\xb5\xeb\xf8\x29\x8b\x9a\xdb\x44\x45\xdf\x19\xd0\xff\x8e\x37\x0f\x98\xab\x34\x48\x92\xee\x82\x47\x47\xa1\x7f\x46\x9d\xd3\xad\x29\x83\xf1\xed\xdd\xb6\xbc\x55\xe0\xee\x2e\xd9\xa5\x93\xc9\x16\x85\xd1\xb1\x6b\x41\x9d\xfa\x56\xad\x59\xd4\x5b\xeb\x76\xed\xb3\x04\xb4\x59\xe8\x47\xfa\xfc\x0a\x62\x7d\xf7\x75\xf8\x5f\xe6\x44\xb2\xdc\xf8\xcb\x97\xfd\x38\x13\x45\x76\xf1\xef\xb7\x1d\xf0\xe1\xa0\xbf\xc4\x78\x59\x2f\x96\x70\x1c\x28\xd3\xff\xda\x1d\x37\x45\xc1\xf7\xe0\x74\xc9\xc9\xf4\x0d\x8f\x91\xb3\x03\x5c\xe2\xfa\x07\x8b\x3c\xae\x11\x5c\x4f\xbf\x82\x27\xc7\xa8\xd7\x84\x4d\x07\xd9\xc6\xe3\xa4\xf7\x6e\xea\xab\x5d\x62\xe3\x37\x13\x36\xed\xf0\xd4\xef\xa6\xd7\x73\x92\x00\xfe\x1f\x28\x01\xd9\x62\x99\xda\xee\x5b\x69\x98\xd2\x2f\xcf\x19\xfd\x57\x8a\x44\x07\x09\xe3\x15\x9a\x5a\x19\xe9\xa3\xf1\xc6\x9c\xba\x08\x5e\x7c\x1e\x36\x16\x23\xe0\xf4\x3b\xfe\xbc\xeb\x89\x60\x91\x64\xf9\xf0\x9c\xcb\x69\xea\x1e\x06\x56\x3c\x07\x24\xc6\xc7\x95\x6c\x31\xe0\x82\xae\x28\xc4\x6b\x22\x79\x29\xff\x9d\xb6\xea\xd0\xe6\xc0\x74\x75\x0a\x6f\xaf\x74\x3b\xc0\xda\xa1\x41\x37\xa8\x61\x98\x07\x2d\x24\x13\x93\x23\x81\x02\x50\xf4\x86\x97\x29\x6b\x81\xc2\xf1\x3d\xc1\xe4\xac\x82\xd9\x84\x84\xbf\x83\x8d\x56\x5d\x64\xf8\x28\xf6\xc0\x5c\x3c\x02\x82\xb8\xd3\xfa\x63\x80\xe6\xd7\x75\x3c\xda\x79\x40\xa5\x6d\x5e\x02\xb3\xf4\x3c\x48\x98\xef\xe3\x88\x47\x3b\xf6\xca\xb4\xf7\x2e\x94\x7c\xb4\xd3\xf5\x8c\x0c\x39\x46\x76\x76\x5a\x3e\x03\x04\xb6\x79\x3d\x46\x3b\x3b\x0e\x72\xf7\xee\x93\x96\xcf\xac\x57\x64\xb4\xb3\x63\xd6\x5a\x88\x85\xb9\xfe\x64\x67\x67\xc7\x1e\xea\xda\xd9\xb9\x19\xed\x78\x1d\xa3\x20\x4d\x7a\x91\x0c\xf8\x66\x2c\xbc\x38\x1e\xed\xdc\x74\x79\xfd\xbc\x94\xbc\xcf\x6a\x8e\x6f\x6b\x42\x46\xfd\x07\x71\x1a\x71\x31\x8c\xd6\x28\xa0\xc6\xbb\x1e\xed\x34\xdb\x58\x3c\x3c\x6d\x61\xe5\x78\xb4\x73\x27\xcf\xd6\xce\xce\xab\x17\x1f\x35\x0b\xb7\x7a\xae\x34\x97\x15\x3b\xea\xf2\x0f\xab\xd2\xfd\x35\xc8\x3e\xd8\x4d\x87\xa2\xb0\xd9\x7e\x38\xc8\x3c\x7b\xd7\x97\x6e\x8b\x6c\x55\xbf\x69\x78\x11\xc3\xfa\x82\x46\x2d\xc0\x03\xaf\x99\xe3\xdf\x3f\x44\xfb\xa1\x28\xe0\x30\x46\xc6\xcb\x6c\xa5\xcf\xc0\x02\x95\x97\x7c\x26\x2b\x0a\x79\xc3\x02\x44\x64\x5b\x21\x5a\xf2\x99\x38\x36\xdb\xa8\x09\x83\xc7\xb7\x90\x2f\x9f\x76\x83\x6b\x2c\xa5\x1f\xac\xc9\xe6\x0a\xe9\x4e\x19\xe5\xe3\xde\x4f\xd8\xa1\x3f\x5d\x50\x9d\x63\xda\x97\xeb\xd6\x39\xa6\x8d\xc2\xc3\xbe\x36\xf2\xf0\xdb\x63\x87\x31\x7b\xe0\x1a\x19\xdd\x8c\xfe\xcf\x00\x8c\xa0\x10\x0f\x47\xa5\x00\x00"
 
 func daoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -119,7 +125,67 @@ func daoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _tableTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xeb\x6f\xdc\x36\x12\xff\x2c\xfd\x15\xd3\x05\x2e\xd0\xf6\xd6\xb2\x0b\x1c\xee\x83\x7b\x5b\xc0\xb1\xdb\x22\x40\x9a\xb4\x4d\x7b\x87\x43\x60\xb4\x94\x38\x5a\xf3\x22\x91\x6b\x92\xf2\x03\x5b\xfd\xef\x07\x3e\xf4\x5a\xaf\x25\xad\x63\x07\x6e\x91\xfd\x90\x78\xf9\x18\xce\x0c\x67\x7e\x33\x1c\x72\x0f\x0f\xe1\x97\x0b\xa6\x20\x63\x39\xc2\x35\x51\xb0\x42\x8e\x92\x68\xa4\x90\xdc\xc2\x4a\x1c\x50\x22\x0e\x52\x41\xf1\x60\x85\x3c\x86\xf0\xf0\x10\xfe\x2b\x4a\x48\x09\x87\x42\x50\x96\xdd\x02\xd3\xa0\x05\x24\x08\x85\x90\x08\xaa\x64\x9a\x24\x39\xc6\x10\x86\x6b\x92\x7e\x20\x2b\x84\xcd\x06\xe2\x1f\x3f\xac\xa0\xaa\xc2\x90\x15\x6b\x21\x35\x44\x61\x30\x4b\x05\xd7\x78\xa3\x67\x61\x30\x43\x29\x85\x54\xb3\x10\x00\x60\x96\x15\xda\xfd\xb5\xd9\x48\xc2\x57\x08\xf1\x2b\x3b\x49\x55\x95\x1b\xb0\xd9\xc4\x55\x55\x0f\x41\x4e\x7d\x7b\x18\xcc\x56\x4c\x5f\x94\x49\x9c\x8a\xe2\xf0\xa2\x24\x9c\x96\x87\x2b\x71\xa0\x2e\xf3\xa4\x64\x39\x45\x39\x0b\xe7\x61\x98\x0a\xae\x34\x44\x70\x78\x68\x19\xfb\xc5\x70\xfb\x5a\x5c\xa3\x3c\x25\x05\xe6\xaf\x28\x72\x0d\x55\x65\x9b\xdf\x90\x02\x41\xad\x31\x65\x19\x43\x05\xfa\x02\xc1\x0a\x07\x9c\x14\x18\x7b\x06\x3c\x89\x5f\xd7\xeb\x7b\x49\x2c\x0d\xcf\x7e\x1c\xd4\xac\x1f\x1e\xc2\x0f\xe4\x66\x60\xfe\x6b\x56\x30\xbd\xb5\x7c\x6e\xdb\x44\x06\x8c\x2b\x94\x1a\x08\xa7\xa0\x30\xc7\x54\x83\x58\x9b\x7d\x63\x82\xab\x38\x0c\xa6\x50\x66\x5c\xc3\x12\xbe\x3a\x3a\x3a\x32\x6a\xb9\x22\xd2\xec\xca\x80\x4a\x4e\x72\x46\x14\xf8\xcf\x00\x75\x3b\x6e\x90\xd2\x77\x0c\x73\x5a\x93\x7a\x7f\xae\xb4\x64\x7c\x65\x98\xe8\xee\xc9\x5d\xc2\x67\x44\x6c\xa9\xe3\xec\xe4\x2d\x88\xe4\x7f\x98\xea\x38\xd4\xb7\x6b\x1c\x9d\xad\x65\x99\x6a\xd8\x84\x01\x4d\xa0\xf9\x7c\xa9\x2e\xf3\xf8\xec\xa5\xdd\x95\x4c\xc8\x14\x7f\x20\x4a\xa3\x84\x44\x88\xdc\x36\x7e\x39\x2a\x6d\x35\xc6\xbb\x53\x9e\xc4\xb5\x44\x85\x5c\x3b\xf6\x89\x6d\x14\x19\x64\x4e\x21\x8c\x7b\xfb\xea\x5a\x4b\x0c\xa3\xa2\x39\xe2\x8d\x70\x9b\xcd\x01\x78\xcf\x39\xd1\x5a\x2a\xf0\x0e\x52\xef\x9b\xb5\xc9\xaa\x02\xa7\xf7\xd6\x11\xac\x87\x9a\xc9\xc8\xa9\xf9\x73\x54\xa8\x6f\xb9\x66\xfa\x76\x5b\xaa\x2e\xf3\x5e\x9e\x82\xac\xd7\x8c\xaf\x1c\x7e\xfc\x98\x23\x51\xa6\x8d\x97\x24\xcf\xcd\xf4\x42\x5c\xa1\x9d\x5a\xae\x29\xd1\xc6\xc9\x56\x90\x49\x51\xd4\x7a\xd1\x17\x44\x03\x91\x08\x5c\x68\x20\x79\x2e\xae\x91\x36\xa0\x43\x8d\x39\xd0\xf1\xfd\xf7\xcc\xee\xad\x25\x4b\xd2\xd0\xae\x2a\xf8\x9d\x26\xc7\xb3\x56\x59\xb3\xdf\x4d\x2f\xcb\x20\x3e\x15\x45\x61\x96\x39\xa8\x2a\xaf\xb2\xba\xc5\x52\xa8\x55\x0a\x5b\xea\xcd\x4a\x9e\x02\xe3\x4c\x47\x73\xd8\xd8\xd5\x5f\x71\xa6\xad\x00\x76\x4f\xa3\x51\x79\x36\xd5\x02\x5e\x8c\x39\xed\x3c\x0c\x1a\xba\xce\xf7\x3e\x9e\xb0\xa3\x33\xf7\x46\xf2\x06\xaf\x47\x5c\x2f\x95\x48\x34\x2a\x20\xc0\xf1\xda\x9b\x45\xed\xba\x56\x0d\xa3\x24\xa2\xf9\xa0\x23\x9a\x45\x36\x61\x20\x51\x97\x92\x77\x58\xdf\x39\x72\x13\x06\x01\x4d\x8e\x61\x95\x8b\x84\xe4\x67\x2f\x17\xe1\x54\x60\x3b\x1e\x57\xf7\x22\x0c\x6a\xe7\x79\xe5\x60\xda\xa1\xb5\x02\xc1\x11\x28\xd1\x04\x24\xa6\x42\x52\x2f\x79\x44\xc7\x04\x9b\x7b\x42\x51\xaa\x6f\xc0\xc7\xcd\xf8\xd4\xfd\xbf\x80\x2b\x92\x97\xa8\x8c\x93\xbd\x77\x2e\x7d\x4e\xf8\xed\x3c\xca\x89\xd2\x6e\xda\xab\x33\x03\xf6\xff\xfc\xc7\x02\x50\x4a\xb0\xc1\x76\xee\xcc\x8d\x65\x90\x23\x8f\x1c\x85\x39\x2c\x97\x70\xe4\x0d\xd1\x7c\xbc\x2e\xbb\x84\x16\x6e\xba\x8a\xdf\xe0\x75\x34\x5b\x13\x49\x8a\x7a\xfd\x94\x70\xe3\x9f\x09\x02\x16\x6b\x7d\x3b\x9b\x5b\x3a\xce\xa9\x52\x91\x2b\x38\x5e\x42\x41\x3e\x60\x54\x23\xfe\x02\x8e\x16\xdd\xe5\xdd\x84\x2b\xd2\x1b\x4a\xf8\xed\xee\x71\x99\x90\xf0\xdb\xc2\x41\x84\x19\xef\x7c\x79\x3c\xea\xb4\xe2\xb1\xcc\x2c\xb6\x00\xf1\xc1\xcc\x77\xc4\xdf\x5b\x7a\xe7\x5f\x9b\xc6\x76\x64\x23\xc2\x12\xc8\x7a\x8d\x9c\x46\xe6\x9b\x5f\x7c\xde\x1b\x66\xd9\x6f\x86\x99\x6f\x76\x83\xda\x41\x15\x74\xf4\xe2\xf5\x6f\xa8\xed\xaf\x7d\x2e\x0c\x65\x46\xbd\x0e\xac\x61\x65\xa2\xe4\xb4\xa7\x7a\x83\x38\x2c\x03\x21\x21\xe2\x08\xf1\x2f\xac\xf0\x18\x10\x9f\x5a\x97\x34\x0d\x30\x9b\xcd\xef\x74\xff\x6a\xc1\xb8\xee\xf6\xe8\x98\x96\xd2\xb6\x1c\x2f\x41\xb3\x02\xe3\x37\xe2\x3a\x9a\x37\xeb\x78\x64\xeb\x2c\x3b\xb4\x64\xd5\xe8\xe0\xb7\xad\x4d\x30\x29\xde\xce\x59\x55\x35\x3b\xff\x1a\xbe\xe8\x6d\xce\xae\x8d\x19\x22\x30\xef\xf8\xba\xe5\x11\x2f\x77\xf1\x68\x20\x7f\xc6\xb8\x9e\x75\x03\xc3\xae\xdd\xf5\x2a\x89\x7f\xe5\xec\x26\x9a\xf7\xa9\x63\xae\x70\xe2\xfc\xad\x89\xad\x26\xab\xe9\xea\xed\x6d\xd9\x64\xf5\xb6\xb3\x1e\xa8\xde\x1e\x81\x31\xf5\xfa\xc1\xcf\x5b\xbd\x2c\x31\xea\x6a\x4f\x0e\xc6\xe1\x9c\x13\xbe\x74\x0d\xde\xe8\x59\x12\x7b\xdf\xe4\x5a\x0c\x05\xd5\xe6\x30\xd0\xcc\x3b\x15\xb9\xb2\xea\x8c\xe3\xb8\x69\xfc\xb7\xdd\x20\xcb\x79\xd3\xac\x2e\xf3\x05\x10\xb9\xb2\xa8\xc8\x92\xd8\x72\x10\xcd\x4d\xa8\x53\x65\xae\x1d\xa8\x1f\x2f\x81\xc6\x34\x89\xbf\xbd\xc1\x34\x32\x33\xcc\x04\x4b\x22\x30\x5b\x20\x25\x7c\xb1\x04\xce\xf2\x76\x5f\xcd\xc9\xef\xed\xd9\xdb\x63\xc8\xc5\xca\xc1\x4a\x18\x04\xbb\x30\xc7\x4c\x36\x11\xad\xee\x74\xcb\xc6\xaf\xdb\x31\x86\x9b\x6e\xbc\xfb\x81\xf0\xdb\x26\xe6\x15\x65\xae\xd9\x3a\xef\x05\x3e\xb5\x77\xe4\x33\x24\x07\xa2\xdf\x6b\xa6\x34\xbc\x3f\xdf\x0a\x81\x10\xb5\xf1\xce\xe4\x06\xdd\x68\x67\x66\x34\x90\x5b\x0b\x6e\xc4\xdc\x0e\x8b\x6e\xe0\x37\x93\x0e\x6b\x77\xc0\x3b\x2b\x74\xfc\xad\x59\x3f\x8b\x66\x12\x53\x64\x57\x48\xe1\x6f\x0e\xab\x17\x80\x37\x29\x22\x35\x39\xb8\x49\x7e\x0b\x72\xc3\x8a\xb2\x30\xdd\xf6\x98\x37\x5b\x6c\x31\xb1\x98\xc2\x43\x0d\xfd\x81\x39\xd0\x59\xdf\x6d\x0e\x58\xb6\xc9\x98\x96\xd7\x96\x0d\xae\x61\x60\x02\x29\xe3\x14\x6f\x9a\x4c\xa2\x89\xa6\xad\x6e\x8d\x8e\x76\x27\x0b\x41\x63\x35\x7b\x64\x06\x81\xb1\xa7\x60\x4a\xa8\x0f\x82\x87\x07\xfa\xc0\xf2\x3c\x12\xe3\x03\x37\xca\x6a\xa0\x23\x53\x10\x0c\x04\x7c\xd3\x5d\xd9\x7f\xef\x0d\xf7\x7e\x44\x2d\xa6\x55\x62\x6f\x9c\x69\xb1\x63\x4d\x7a\x5e\x35\xc6\xd9\x4b\x05\x76\xaa\x76\x28\xec\x07\xfb\x06\x5f\x63\x12\x17\x44\x9d\x50\xea\x7a\xdb\x43\xef\x9d\xb8\x61\x18\x7e\x7f\x74\xfe\xa9\x82\x73\x8f\xab\x25\x68\x59\xe2\x63\x84\xc3\x56\x60\xd7\xbb\xbf\xc0\x4f\x15\x2e\x7b\x5c\x0d\x09\x1c\x4c\x89\x4e\xc1\x43\x42\x53\xb0\x33\x2e\x4d\xce\x20\xc7\x53\xc8\x60\x52\xfe\x58\xbb\x7c\x8d\x0f\x0d\x18\x29\x8f\x45\x5b\x19\xc6\x04\x3b\xf7\x1b\xdc\x33\xaa\x7e\x7e\xbf\x7f\x32\xb8\x6f\xc6\x72\x5f\xd6\x32\x39\x73\xd9\x61\xf8\xad\x81\xdc\xd3\x39\xdd\x33\x7a\x2a\xf2\x66\x38\x45\x45\x43\x09\xdd\xb3\x56\x51\x10\xec\xc8\xb8\x0c\x14\xdf\x9b\x71\xfd\xe6\x92\xad\xed\x5c\x0b\xee\x49\xb6\xb6\x10\xbc\x9b\x44\x71\x96\x9b\x94\xc9\xe4\x4c\xdf\xa3\x36\xe9\x82\x64\x78\x85\x77\x4a\x04\xae\xf6\x55\x20\xfa\xe2\xda\x65\x89\xf2\x16\x52\xc9\x34\x4a\x46\xf6\x48\xa3\xbe\xc7\x7b\xaa\x07\xa9\xe0\x54\x41\x1c\xc7\x03\x14\x4e\x05\xa7\x73\x88\x06\x62\xae\xaf\xae\x0d\xb1\xe1\x86\x74\x6b\x10\x46\x3f\x6a\x07\x96\xbd\xb3\x15\xed\x0e\x96\xa9\x24\x76\x6d\x43\x2c\x78\x73\xb4\xdb\xa0\x92\xf8\x3b\x29\x8a\xa9\xb0\x27\x0c\x0f\xc3\x05\x28\xa3\x02\x83\x8b\xbc\x59\xe2\x32\x3f\xf1\x26\x62\x39\x1d\x9d\xfb\x42\x19\x70\xe5\x74\x01\x2f\x84\x63\xf1\x3f\x17\x28\x31\xf2\x84\xda\x7c\x3f\x89\xdf\x4a\x8a\xf2\xe5\x6d\x64\x02\xc7\x8f\x92\x15\x44\xde\x9a\x50\x11\x9f\xa1\x4a\xbd\x3e\x6c\xb2\x17\x7d\x35\xdf\xb2\x56\xd5\x5a\xab\xf7\x67\x1a\x77\x6b\xda\xad\x43\xab\xcb\x1c\x96\xf0\x5d\xdb\x67\xb9\x35\x9b\xf8\x77\xd3\x57\xa7\x91\x52\x5c\xab\xfe\x11\xe3\x27\x63\x82\x53\xed\xde\xda\x3c\xc5\x0c\x25\x18\x4a\xf1\x69\x2e\x14\x46\x6e\x82\x6d\x78\x83\x37\xae\xfa\x19\x0c\xdd\x18\x78\xf3\x5a\x0e\xd6\xf5\xea\xc2\xe5\x30\xa9\x77\xae\x00\x7c\xd7\xe8\x6c\x7b\xc4\xf1\x7a\xbc\x38\x6a\xf3\x52\x87\x04\x56\x88\x77\x29\xe1\x43\xa6\xe9\x68\xc7\x27\x94\xca\x71\x27\x9a\x37\x96\xe0\x77\xb0\xa7\xd8\x2e\xd0\x79\x2c\xe9\xa0\x5c\x8b\x30\xfe\x40\x76\x2a\x4a\xae\xfd\x40\x7f\x83\x25\x34\xc9\x81\x97\x45\x82\x12\x44\xd6\x3b\x96\xb5\x68\xf3\x71\x60\x63\x57\xfd\x68\xb8\x71\x9c\x32\xae\x3f\x12\x33\x66\xa9\x65\xe7\xcb\xf9\xec\x4f\x8c\x0c\xcf\xd3\xcd\xeb\x55\x06\x8a\x09\xfb\x20\xc0\xb6\x4b\xbd\xb0\x26\xf0\x68\xce\x60\xb3\xc7\x36\xd4\xee\xac\x4c\x3c\x96\x0b\x98\xb5\x76\x7b\x80\x3d\xdc\x2f\x40\x64\x99\x42\xed\xcc\xfb\x71\x62\xb0\x3f\xd6\x7f\x8e\xc1\x9f\x22\x06\x7b\x73\x74\x17\xf2\xff\x32\xc7\xf5\x3f\xfe\xf0\xdf\xf6\xa9\x15\xb5\xa1\x7c\x7a\x6d\xc7\x65\xc7\xdb\xf3\xf3\x6e\xf5\xc7\x73\xe7\x6d\xec\x9b\xf6\x62\xc1\x8c\x7f\x6b\x5b\x23\xd7\xd9\xd4\x8b\xfe\x72\xf8\xf2\x09\x72\x00\x73\x4e\xdd\x3f\x8b\x39\x9e\x9c\xc6\x3c\xcb\x14\x63\x48\xc2\x7e\x99\x6b\x64\xe0\x62\xa8\x88\xe7\x19\xbd\x0b\xe2\x27\x79\xde\xc1\x70\x92\xe7\x4f\x02\xdf\x27\x79\x3e\x80\xde\x9f\x51\xfb\xaf\x80\xda\xdf\xf4\x50\x71\x07\x8a\x7e\xc6\xc4\xcf\x98\xf8\xa7\xc0\x44\x5f\xad\xf3\x8f\xa1\xdc\x39\xef\x91\x01\xd1\x2d\xb1\xc7\x03\x94\x87\x1d\xf5\xfa\x8f\x54\x3a\x97\x76\x96\xd8\xce\x0b\xbb\xa0\xdc\x01\x84\x8e\xdd\x0e\x10\x96\x89\x2f\x54\x4e\x45\x36\x7b\xbb\x62\xf7\x6e\xfc\xdd\xca\xd6\x1d\xda\xd6\x05\x9a\xbb\x8a\x6a\xe9\x35\xb6\xd0\x34\x2d\xa0\x4c\xe2\x13\xa5\xd8\x8a\x47\x2d\x99\xb9\xdb\x69\x4f\xc3\x5a\x62\xa3\x8e\x66\xea\xbd\x77\x98\xfb\x15\x7e\x1f\x56\xda\x9d\x2a\xd3\xd0\x05\xc8\xa2\x73\x11\x50\xd7\x83\x87\x6f\xf9\x9f\x62\xe5\xed\x25\xfb\x57\x4b\x75\xc1\xb8\x34\xd1\x54\xb7\x2b\x39\x80\xfe\x34\x31\xb0\xec\xc5\xc0\x72\xbc\x46\x50\x4e\x7d\x2a\xf0\x08\xb1\xa6\xff\x34\xe0\x67\x71\xad\x4e\xb2\x0c\x53\x8d\xed\xd3\x80\x33\xcc\x51\xa3\x7f\xed\xf9\x24\x10\xe5\x56\x78\xbc\xb2\xd3\x43\xb0\x88\xee\xc0\x22\xc7\x57\x07\x8b\x68\x12\xbb\xb6\xe7\x97\x69\xd1\x9e\x95\xd1\x71\x2b\xa3\xcf\xcb\xca\x6c\x06\x05\x78\x83\x69\xe9\x5e\x9b\xa6\xa5\xd2\xa2\xf0\x46\x45\x38\xed\xd5\x42\x77\x1b\xe0\x03\x4c\xcf\x25\x6e\x6e\x91\x3a\x48\x58\x0d\xc5\x71\xec\x5e\xa2\xd8\x47\xe6\x3f\xd7\x19\x9f\xb3\xa7\x91\x34\xd2\x91\xbb\x2f\x91\xb4\xbd\x9d\xe3\xbe\x57\x4e\x27\x8f\xb4\x23\x3a\x7a\x77\x1a\x32\x1b\xb2\x43\x41\xef\x7e\x7a\x0d\x4a\x13\x8d\x05\x72\xbd\x87\xe4\x76\x7f\x87\x04\xb7\x72\xb7\x86\xf1\xc4\x92\xb7\xec\xf4\x05\x37\x92\x77\xa8\x01\xa1\x54\xf9\x57\x37\xf6\x3b\xab\xc9\x9b\x48\xde\xfe\x94\x02\x44\xe6\x4c\xa2\x94\xd2\x08\xed\x5f\x2c\xc3\x74\xfd\x74\x16\x8d\xfc\xa3\xdb\xbe\xd4\xfe\xa6\xdf\x83\x24\x53\xf6\x91\x76\xa7\xbf\x0b\x98\x4f\xcd\xec\xdd\xe5\xef\xe3\x39\x23\xb9\xc2\xe6\x09\xfb\xa4\x04\x52\xe1\xa9\xe0\x3c\xa2\x49\xfd\x83\x8b\x86\x34\x4d\xc0\x00\xc9\x5e\xe4\x4e\x73\xc1\x1d\xc1\x39\x74\x69\x7a\x2b\xea\xd8\x44\x58\x85\xff\x0f\x00\x00\xff\xff\xb0\xc0\x8f\x90\xe6\x34\x00\x00"
+var _dao_testTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\xac\xae\x06\xa4\x8d\xa2\xc4\x69\xda\x03\x52\x78\x81\x6d\xd3\xde\xf5\xda\x7a\x17\x75\x7a\x7d\xc8\x15\x29\x2d\x8d\x64\xc2\x12\xa9\x92\x94\x1d\xaf\xe1\xef\x7e\x18\x8a\xb2\x64\xd7\x4d\x7a\xf7\x92\xc8\xe4\xcc\x6f\x7e\xf3\x87\xa3\xa1\xce\xce\xe0\x66\xce\x35\x64\xbc\x40\x58\x31\x0d\x39\x0a\x54\xcc\x60\x0a\xb3\x35\xe4\xf2\x34\x65\xf2\x34\x91\x29\x9e\xe6\x28\x3c\xaf\x62\xc9\x82\xe5\x08\x9b\x0d\xc4\x7f\x2e\x72\xd8\x6e\x3d\x8f\x97\x95\x54\x06\x02\x6f\xe0\x27\x52\x18\xbc\x37\xbe\x37\xd8\x6c\x4e\x81\x67\x10\xdf\xac\x2b\xd4\xf1\x3f\x50\x96\x68\xd4\x9a\xe4\x07\x3e\x8a\x44\xa6\x5c\xe4\x67\x33\x2e\x98\x5a\x3b\x69\x14\x69\xb3\x9d\x95\x8f\x01\x94\xcc\xcc\x0f\xb5\xa4\xf6\xbd\x81\xaf\x8d\x4a\xa4\x58\xba\x47\x2e\x72\xbb\x6a\x50\x1b\x2e\x72\xfb\xc8\x4b\xf4\x3d\x6f\xe0\xe7\xdc\xcc\xeb\x59\x9c\xc8\xf2\x2c\x97\xa7\xfa\x5b\x71\x9a\x2a\xbe\x44\x75\x56\xae\xf5\xb7\xa2\x47\xe0\x03\x1a\xc5\x13\xdd\x58\xe9\x69\x55\x8a\x28\xcd\xb1\xd6\x67\x49\xc1\x51\x98\xbb\x5c\x16\x4c\xe4\xbd\x8d\x7d\x8e\xa1\xe7\x9d\x9d\x01\x71\xb9\x9e\x4e\x5e\x8b\x25\xe8\x0a\x13\x9e\x71\xd4\x60\xe6\x08\x28\x96\x5c\x49\x51\xa2\x30\xb0\x64\x8a\xb3\x59\x81\x20\x33\xbb\x77\x3d\x9d\xb4\x8f\x29\x33\x6c\xc6\x34\xda\x75\x63\x85\x08\x52\x83\xaa\x05\xb0\x9c\x71\xa1\x4d\x4c\x86\x6e\xe6\xed\x0e\x17\x1a\x95\x01\x26\x52\x48\xb1\x40\x83\x90\xf1\x7b\x53\x2b\x04\x25\x57\x3a\x02\x2d\xa1\xd6\x08\x0c\x52\x4c\x79\x62\x73\xbf\xb3\xb2\xe2\x66\x6e\x4d\xe9\x64\x8e\x25\x6b\x59\x58\xc3\xfa\xc0\x0e\x53\x08\x7a\xc1\xab\x0a\x53\x8a\x1c\xc9\xed\x1c\xe1\x1a\xb0\xac\xcc\x3a\xf6\x12\x29\xb4\xe9\x87\x61\x0c\xfe\xf5\xef\x7f\xdc\xdd\xbc\x9e\xde\xdc\x5d\x4f\x27\xbe\xe7\x2d\x99\xa2\x72\x72\xd1\x7a\x25\x45\xc6\xf3\x83\x68\x25\x76\xb1\x56\xcc\x70\x29\x76\xac\x50\x9b\x5d\x80\x22\x10\xbc\x20\x1e\xdc\x00\xd7\x20\xa4\xd9\x29\x61\x1a\x7b\x83\x1e\xf4\xaf\x36\xe7\x71\xf3\xcb\xda\x75\x01\x9a\x22\xa6\x50\xb2\x85\x33\xda\x86\x6d\xc9\x8a\x1a\x35\x59\x65\x96\xa1\x8d\x7d\xca\xb3\x0c\x15\x65\x2f\x53\xb2\xb4\xf2\x14\x5e\x92\x92\x66\x8e\x8a\x84\x74\xec\x0d\xfa\xd0\x63\x30\xbc\xc4\x78\x22\x57\x41\x18\x7f\x12\xfc\x7e\xc2\x84\x0c\xc2\x3e\x85\x1b\x5e\xe2\x81\xef\xd6\xfc\xce\x67\xda\x4f\x64\x51\x97\x42\xb7\x6b\x4e\xd5\xa6\xb7\xb3\x68\x91\x9c\xc5\x6b\x66\x30\xb8\x38\xbf\xb8\x8c\x60\x14\xc1\x45\x04\x4f\x23\xb8\x8c\xe0\x59\x04\xe7\x51\x23\xf1\xe9\xe6\x55\xe8\x85\x9e\x97\xd5\x22\x81\x1b\xd4\xe6\x03\xe3\x22\x28\xe1\x57\x77\x98\xe2\x0f\x21\x6c\xbc\x01\xcf\x20\xd5\x02\xae\xc6\x20\xe9\x94\x1a\x14\xcb\xa0\xcb\x6d\xf8\xc2\xee\xfe\x32\x06\xdf\x27\xe9\x41\x92\xe5\x11\xa0\x52\xa4\xd0\x04\xfd\x4f\xa6\x34\x5e\x4f\x27\x41\xaa\x45\xe8\x0d\x08\x90\xf6\x7f\x19\xdb\xf4\x91\xce\x20\x2b\x4d\xfc\xa6\x52\x5c\x98\x2c\x90\x3a\x9e\x9a\x14\x95\x8a\xc0\xaf\x48\x15\x86\x1a\x32\xc6\x0b\x4c\x23\x18\x2e\xff\x23\xfc\xa8\x57\x5b\xd6\x16\xa1\x0e\xa4\x8e\x5f\xdf\x73\x13\x8c\xe8\xd7\xb6\x61\xd2\xd8\x6e\xc3\xa2\x6a\xf4\x06\x14\xf8\x4f\x55\xca\x0c\x82\x42\x53\x2b\xd1\x24\xbe\x64\x26\x99\x63\x6a\x03\x0a\x8a\xd9\x74\x9a\x39\x13\x76\x33\x99\x33\x91\x63\x0a\x52\xa0\x76\xc0\xaf\x6c\x43\x78\x23\x6b\x91\x7e\x24\x95\x3e\xfc\xcd\x41\x82\x20\x95\xb6\x38\x15\xda\xf2\x49\xf0\x48\xe9\x34\xc7\xad\x89\x8e\x23\xce\x4a\x0d\xe3\x5e\x90\xfa\xcb\xb6\x64\x83\x92\x55\xb7\x4d\x13\xfc\xd2\xfc\x6b\x5d\xe7\x19\xdc\x45\x20\x17\x94\x84\x4e\xed\xd6\xcf\xa4\x42\x9e\x8b\xbb\x05\xae\xef\x92\x39\x26\x0b\xed\x7f\x79\x01\xbf\xc8\xc5\xa1\x85\xe3\xa2\x30\x06\xff\xdc\xef\x6c\x50\x1a\xc7\xc0\x05\x37\x54\x3e\xd7\x2f\x83\x24\xcb\xc3\x17\x3f\x9d\x5d\x52\xdc\x3f\xd1\x87\x79\xfe\x61\x6e\x7b\x47\xdb\x7a\xe8\xd1\x2a\xbd\xc4\xc8\xe3\x32\xfe\x58\x0b\x3a\x63\xaf\x0a\xa9\x91\x1e\x5a\x7d\x92\x08\xbd\xad\x6d\xd3\x1d\x6d\xfb\xc8\x59\xc1\xff\x72\xc7\x2f\x91\x42\x60\xf2\x40\xdf\x59\xcd\x79\x32\xa7\x9e\xa3\x30\xe7\xda\xa0\xc2\x14\x32\xa9\x00\x97\xa8\xd6\x3b\x39\xb2\xd2\xea\xdb\xf4\xda\x46\x6c\xe6\xcc\xf4\xd6\xc8\x82\x26\x3d\x56\xec\x14\x9b\x46\x4b\x36\xa9\xcf\x8a\xef\x19\xc4\xcd\xa1\xdd\x8f\xfc\x7e\x93\x0b\x29\x0d\x52\xb9\x13\xec\x0e\xe4\x5b\x61\x83\x60\x5f\xe1\xf1\x4b\x96\x2c\x72\x45\x15\x1c\x84\x11\x1c\x4d\x5d\x73\x42\x68\xd5\x06\x98\x5c\xbc\x8b\x76\x2c\x28\xd6\x8a\x4e\x06\xdc\xba\xfa\xdb\x00\xbd\xd4\x9b\xb5\x27\x3c\x82\x27\xe9\x8c\x84\xe2\x6b\xa7\x41\xef\xd8\xcd\x86\x5a\xf6\x13\x0e\xdb\x6d\x44\x53\x46\x33\x15\xf8\x9b\x8d\x95\x6e\x9e\x68\xed\x74\xbb\x85\x2d\xd1\xa7\x4a\xdb\x99\x1c\xdb\x74\xc7\xd7\x2f\x27\xac\x44\x57\xb5\x52\x18\x2e\x6a\xdc\xaf\xcb\xab\x31\x7c\x74\xc9\xf9\x81\xc7\x2d\xe6\x0f\x7c\x6f\x9d\xa7\xde\xf4\x9a\x42\x99\x05\x7e\x9b\x6e\x18\xea\x08\x86\x2b\xbf\x0f\xe2\x6a\x75\x6b\x23\xe5\x74\x05\x2f\x5c\xb5\x29\xfc\x56\x73\x85\xae\xe0\xf4\x82\x57\xba\x4b\x2b\xcf\xbe\x4f\xf1\xb1\x37\x9a\x4d\xfa\x1e\x52\x60\xba\x76\x7d\x63\xdb\xb5\x89\xff\x89\x45\x85\x8a\xca\x9e\x70\x7b\x07\xa5\xf3\xcd\xc4\xd3\x05\xaf\xb2\xc0\x1f\xea\xd6\x8e\x46\xb3\xd7\x58\x43\xf2\xa3\xe1\xee\x7a\xd9\xa4\x2e\x8a\x5d\xcf\x9c\x7c\x7a\xff\xde\x96\x3c\x39\x21\xd3\x74\xaf\xe1\x45\x76\x12\x59\x76\xfb\xd4\x4e\xb5\xe3\xdf\x43\x0b\x04\x70\x61\x22\x58\x02\x13\xeb\x90\xfe\xb8\x72\x15\xc3\x0b\x6a\x7d\xa3\x7e\x11\x52\x2c\x7b\xa1\x5d\xee\x93\x7b\x2b\xcc\x8e\x1b\xb3\xb0\x98\xa3\xa2\xc3\x73\x7b\x1e\x41\xc9\xee\xff\x4d\xaf\xd4\x2f\xed\x79\x14\xa7\x66\xde\xa7\xbc\xcf\xed\xad\x30\x2d\xb5\x56\x93\x7e\x3d\xbf\x0c\x9b\x7f\xb0\xd9\xd1\x08\x9c\xca\x14\x31\x1d\xee\x84\x4f\x1a\xb9\x40\x84\x21\x0c\x21\xe8\xad\x8f\xda\xf6\xd3\xea\xd9\x73\xd3\x51\x87\xe6\x20\x11\x4f\x66\xa0\x94\xda\x80\xe6\x7f\x21\x24\x73\xa6\x58\x62\x50\xe9\x9f\x73\x61\x6a\x61\x5a\x2f\x2c\x04\x17\x26\x6c\xe1\x37\xde\x40\xd3\xc9\x74\xd3\x74\xfc\x46\xaa\x92\x19\xf2\xbb\xd5\x47\x4c\x4f\x5a\x27\x22\x78\xfa\xbc\x29\xa7\x02\x45\xa0\x43\xf8\xad\x21\x45\xd9\x39\x3b\x83\x77\x88\x95\xa5\x64\x14\xe3\x05\xc1\x77\x6c\xdb\x5e\xd9\xcc\x4e\x30\x43\xb3\x42\x14\xbb\xd9\xc9\x1b\x0c\xe8\xdd\xa9\x6f\x1b\xe0\x53\x82\xbd\xfa\xd2\xcf\xb3\xde\x8f\xd7\x35\x26\xbc\x64\x5d\x1d\xd2\x48\xdb\xac\xb8\xb0\x54\x0a\x13\xae\xa9\x75\x53\x0d\xea\x84\x15\xf8\x73\x11\x73\xc8\x81\x88\x3a\x8c\xc8\x01\x1c\x0b\x9d\x7d\x13\xf2\xac\x13\xa6\xa8\x58\xe9\x4d\xeb\x95\x0b\xee\x5b\x23\x59\x20\x60\x08\xa3\x73\x7b\xaa\x28\x90\x0d\xee\x6f\x70\x4e\xb5\x34\xd0\x70\x32\x06\x3f\xf6\xe1\xc4\x59\xd1\xf1\x47\xac\x90\x99\xc0\x7f\xe6\x3b\x12\xe1\x03\x51\xf9\xd7\xf4\x8f\x49\x2f\x24\xf6\x67\x2a\x93\xda\x5e\x35\x7e\xca\x7b\x52\x09\xc4\xa1\xa3\xce\xda\xd7\x8d\x2f\xfc\x2b\xf8\x0a\x27\x07\x3e\x85\x70\x02\x5f\xb7\x5f\xbd\xad\xf7\xe3\xcb\x5c\x8f\xe6\xe7\x77\x2f\x77\x2c\x29\x21\x2b\x2c\x8a\xd3\x85\x90\x2b\x01\xcd\x45\x91\x32\xc5\x20\x6f\xb5\x1d\x73\x5d\x31\xc3\x59\x01\x66\x5d\xe1\x3e\xeb\xcf\xef\x5e\x06\x6e\x97\x0c\x3b\xe6\x21\xdc\x7e\x99\xad\x8d\xad\xcf\x4a\x72\x61\x28\x5d\xa4\x16\xcc\xdc\x4e\x04\xf7\x11\xac\x21\x2b\x24\x33\xcf\x2f\xfb\xf2\x83\x19\x8c\x1d\x99\xf8\x3d\x37\xa6\xc0\xd7\x22\xe5\x4c\xc4\xbf\x57\x15\x8a\xf4\x53\x73\x24\x66\xd4\x18\xcc\x3c\x7e\xd3\x00\xcc\xb8\xd1\xc1\x7d\x18\x76\xcd\xea\xff\x42\x58\x13\xc2\xd6\x1b\xec\xfc\x6f\x69\x9b\x75\x05\x35\x17\xe6\xe9\x45\x04\x33\x99\xae\x1d\xdf\x03\xde\x57\x8f\x11\x7f\x7a\x11\x34\x0a\x9b\xd1\x36\xa2\x68\xf6\x08\x33\xeb\x1e\xd1\x22\x03\x71\x1c\x87\x6e\xa4\xaa\x7b\xe1\x13\x8f\xb2\x38\x40\x7b\x8c\x8e\xe0\x45\x04\x22\x3c\x30\x5a\x70\xd1\xf6\x45\x9a\x5f\x65\x2d\x4c\x70\x11\x81\x4d\x65\xd0\xfc\xb5\x8a\xe7\x11\x9c\x87\xf6\x7a\x33\xa2\xc8\x55\xb2\x58\xe7\x52\x74\x3a\xa3\xc8\x3d\x5c\xee\x2b\x3f\x00\x44\xff\xce\x23\x18\x85\x6e\x91\x70\xf5\x8a\x9b\x64\x0e\xfd\x3a\xdb\x78\x83\x84\x06\x20\x9f\xa8\x36\x45\xe7\x5f\x75\xee\xb7\x09\x24\xd6\x9d\x33\x61\xab\xe4\x88\x1e\xd5\x78\x4a\x54\xed\xf6\x4e\xbc\xac\x0b\xc3\x2d\xdb\xa3\x1a\x97\xad\x97\xa3\xa8\x5b\x1c\xb5\x1e\x5b\xff\x9a\x08\x85\xfb\x88\x8f\x50\x7f\x76\x0c\x76\xdf\x9f\x03\xc0\x87\xdc\x7a\x7e\x0c\xad\xe7\x6b\x07\xd5\xee\x26\xb2\x28\x9a\x19\xdc\x8f\x9a\xd5\xde\xca\x31\x13\x7f\xff\x5f\xe2\xb0\xf5\xbe\xd3\x3f\x26\xea\x1a\x9b\xfb\xbc\x73\xe4\x7b\x11\x35\x37\x9a\xe9\xdc\xd2\x47\xdc\xdd\x69\x74\x37\xe8\x77\xd7\x09\xdd\xbb\x6a\xa4\xcd\x97\x97\xcf\xdc\xcc\x5b\x40\x9a\xfa\xfb\x02\xf6\x63\x0f\xb0\xcc\xa0\x02\x7b\x97\x89\xc8\x1e\x8f\x31\x6e\x7e\x42\x2d\xda\x89\x94\xcc\x21\x54\x52\x16\x50\x3a\x34\x99\x1d\x5a\x77\xcd\xf3\x3b\xc2\xdf\x4f\x91\x0a\xed\xd9\xeb\x3e\x74\xc5\x13\x5c\x35\xf3\xb4\x5a\xd3\x68\x49\xa3\x1d\xef\x2e\x00\x17\xb0\xd9\x1b\xbd\x1f\xb8\x68\x34\x97\x94\x09\xae\x9a\xfb\x5b\x10\x46\xfd\x18\x04\x0a\xf3\xf0\xc8\x30\x6e\xe2\x37\xcc\xb0\x22\x0b\x7c\x0b\x1d\xc2\xdf\x86\xa9\xbb\xe1\x8c\x61\xb8\xf4\x23\xe0\x27\xa3\xfe\x10\xde\x5d\xfe\xb6\x87\x83\x70\x0f\xb7\x63\xdc\xbb\x4b\x75\xa2\x0f\x12\x79\xf0\xfa\xda\x5d\x5e\xed\x85\x60\xaf\x8e\xfe\x3b\x00\x28\x92\x22\x38\x92\x15\x00\x00"
+
+func dao_testTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dao_testTpl,
+		"dao_test.tpl",
+	)
+}
+
+func dao_testTpl() (*asset, error) {
+	bytes, err := dao_testTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dao_test.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _metricsTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x4b\x6f\xdc\x38\xf2\x3f\x47\x9f\xa2\xfe\x3a\x04\x92\x23\xab\x2f\x7f\xec\xc1\x33\x5e\x20\xb1\x83\x4d\x80\xd8\x9e\xd9\x3c\xe6\x10\x04\x0d\x36\x55\x2d\x11\x96\x48\x85\xa4\x62\x37\x8c\xfe\xee\x8b\xa2\x28\x89\xea\x87\xd7\x71\x06\xd8\xe9\x01\x22\x93\x55\xf5\xab\x2a\xd6\x8b\x5c\x2c\xe0\x53\x25\x0c\xac\x45\x8d\x70\xc7\x0c\x94\x28\x51\x33\x8b\x05\xac\x36\x50\xaa\xd3\x82\xa9\x53\xae\x0a\x3c\x2d\x51\x46\x51\xcb\xf8\x2d\x2b\x11\x1e\x1e\x20\xff\xe3\xb6\x84\xed\x36\x8a\x44\xd3\x2a\x6d\x21\x89\x5e\xc4\x5c\x49\x8b\xf7\x36\x8e\x5e\xc4\x05\xb3\x6c\xc5\x0c\x2e\xcc\xf7\x9a\xfe\x46\xad\x95\x36\xf4\x65\xac\xe6\x4a\xfe\x70\x9f\x1b\xc9\xe3\x28\x7a\x11\x97\xc2\x56\xdd\x2a\xe7\xaa\x59\x94\xea\xd4\x7c\xaf\x4f\x0b\x2d\x7e\xa0\x5e\x34\x1b\xcf\x1f\x50\xb4\x5a\x35\x68\x2b\xec\xcc\x82\xd7\x02\xa5\x5d\x96\xaa\x66\xb2\x0c\x36\xe2\x28\x8d\xa2\xc5\x02\xae\xd0\x6a\xc1\xcd\x35\x6b\xd0\xb4\x8c\x23\x98\x16\xb9\x58\x0b\x34\x60\x2b\x04\x39\xae\xab\x35\x5c\xbe\xbe\x81\xa6\xa7\xcf\x23\xae\xa4\xb1\xfb\xec\xe7\x10\x17\x4c\xc5\x51\xf4\x83\x69\x48\x22\x00\x18\x58\xae\x3a\x30\x1b\xc9\xf3\xab\xce\xe2\xbd\xdb\xd0\x58\x0a\x63\x35\x61\x9d\x43\xc3\x6e\x31\x69\x58\xfb\x75\xd2\x31\xff\xb7\x23\x40\x8d\xfa\xdb\x89\x97\xf2\x4e\xa9\xdb\x14\x16\x8b\x81\x79\x03\xa7\xff\x84\x4a\xa9\x5b\x6f\xcf\x5f\xc2\x56\x5e\x29\x4f\x82\xba\x37\xc5\x0b\x18\x0c\x51\x2d\x9d\xa2\x50\xd2\x00\x93\x05\x70\x25\x25\x72\x2b\x94\x84\x56\xa9\xda\x80\x90\xc4\x7f\x16\x2d\x16\xe4\x26\x80\x53\x28\x98\x5a\x4e\x5c\x4b\xab\x2c\xab\x1f\x8a\x55\x66\xd9\xaa\xc6\x6c\xdc\xd9\x1e\xa4\x5f\x16\x9d\xff\x30\xc8\x95\x2c\xcc\xd3\x39\xfb\xc0\x38\x8a\x97\x51\xf0\x6d\x33\xa0\x7f\x40\xf4\xb6\x5e\x6d\x3e\xfe\xf9\x01\x1c\x23\xc8\xae\x59\xa1\x06\xa5\x21\x56\xb6\x42\x1d\x87\x28\x64\xeb\xf2\x84\xa4\xd2\xd7\x16\x4a\xd6\x95\x38\x78\xa4\x93\xce\x79\x6a\x0d\xe6\x7b\x9d\x5f\xbe\xf9\x68\x99\x35\x99\xf3\x0f\x01\xb5\x5a\x34\x4c\x6f\x48\xb2\xc6\xb6\x16\x9c\x5d\x7b\x6f\x7d\xaa\x10\x46\xfd\x46\xc7\x33\x8d\xe3\x91\x60\x01\x4a\x72\x84\x16\xb5\x5f\xd3\x1b\x87\xaa\x56\x06\xf5\x0f\x04\x1b\x8a\x70\x3a\xb0\xba\xa6\x83\x33\xf9\x80\xe0\xf4\x08\x85\x77\x32\x10\xbf\xda\xc0\x45\xad\x0c\xe6\xd1\xba\x93\x3c\x8c\x8b\x44\x63\x09\x07\xa3\x2c\x85\x9b\x96\xf0\xe0\xc1\xc7\xa7\xed\xb4\x04\xe2\x4f\x14\x9c\x28\xb7\x67\x52\xbf\x4b\xff\xab\xdc\xa0\xed\x5a\x8a\x5f\xd6\xb6\x28\x8b\x64\x58\xc9\x7a\x36\xca\x1f\xa0\x18\x97\x65\x06\x1c\x4e\x78\xdd\x91\x7e\xa9\x3f\x9b\x49\x12\xfd\xc4\x9a\x96\xe1\xec\x7c\x74\xd3\xcd\xe0\x81\x40\xf5\xf4\x37\x47\xf5\x7f\xe7\x20\x45\x1d\xe8\x32\xfc\xbc\xd6\xa8\xf5\x6c\x6b\x3b\xfb\x8b\xab\xba\x46\x6e\x95\x43\x93\x78\xf7\x87\x52\xf5\xc5\xb0\xe6\xb4\xce\x80\xa7\xc7\xb5\x1b\x9d\x96\x8c\x92\xfe\x1e\xbd\x72\x4e\x87\xa6\x03\x8f\x8e\x4b\xde\xa5\xa1\xff\x87\xff\x34\x96\xf9\x67\xa9\xf7\x75\x9a\x51\x6e\xd3\xe8\x80\x42\x52\xd4\xd1\x0e\xc5\x36\xda\xba\x62\x72\xec\x18\xc6\xf3\x31\x60\x0f\x86\x7a\x5f\x3c\x5c\x40\xb3\xa2\x70\x54\x42\xbb\x32\x95\xed\xc7\xbd\x8f\xd0\x63\x60\x8f\x85\x6b\x18\x45\x1e\xfb\xaa\xcb\x3f\x28\x7e\x9b\xf4\x96\x14\xb8\x46\x1d\x6c\x7d\x96\xf5\xb4\x29\xd6\xb0\xcc\x40\xdd\x4e\x11\x47\xb5\xf8\xab\xc6\xf2\xdb\x6f\xb4\xfc\x10\x1d\x71\x55\x1f\x4b\x35\x5b\x61\x6d\x88\xf9\xeb\x37\x62\x95\xe5\x43\x5c\xac\xe2\x0c\x62\x57\xa1\xe8\x63\xf4\x4c\xdc\xb3\x54\x44\xfd\xd2\xab\x43\xa5\x7c\x82\x18\x49\xcd\x59\x68\xed\x35\xde\x5d\xf4\x85\xe8\x0b\xf2\x24\xd8\xf0\xab\x37\xad\x35\x93\x10\xfa\x8d\xbd\xe8\x6c\xaf\x3b\x65\x7b\x84\x67\xee\x0b\xe2\xdd\xba\x1e\xcf\x49\xdf\x61\xdd\x0e\xa4\x9f\x68\x7f\xac\xaa\xbb\xcd\x24\x0f\x38\xb7\x99\xf7\x51\x3a\xad\x0d\x6d\x60\xd7\xc8\x77\xc2\x58\x55\x6a\xd6\xec\x98\x39\xae\xff\xdd\x86\xee\x35\xa4\x47\x4c\xbe\xf4\xa4\x07\x7a\xa7\x90\xe0\xf9\x43\xcb\xe9\xf7\xa6\xe3\xb7\x68\x0d\xb9\x2d\xb0\xe7\x12\xd7\x7e\xe3\x71\x3f\xb9\xc8\xfe\xdf\x86\xc2\xac\xf1\xfe\x44\x40\xac\x99\xa8\xb1\xd8\x75\xd4\x6a\x73\xa0\x2b\xef\x44\x8b\xaf\x78\x7d\xd0\x64\x10\x53\x4b\x8f\x53\xef\x95\x3e\x83\xd6\x4a\x53\xd2\x8e\x15\x8e\x12\x4a\x33\x59\x22\x7c\xfd\x36\x73\x89\xdf\x7f\xa8\xf2\x49\x89\x0c\xaa\x7c\x38\x76\xfa\xee\x0d\xdc\x06\x89\xfe\x2b\x45\xfe\x40\x81\xdf\x06\x9a\xef\x54\x18\x38\x87\xca\xed\xbe\x2e\x0a\xaa\x04\x49\x95\x86\x4d\x97\xaa\x72\x5f\x84\x83\x6a\x01\x1a\xb9\xd2\xc5\xb1\xaa\xdb\x47\xa7\xc9\x23\xbb\x69\xc7\x79\x8f\x64\x53\x07\xee\xb8\xf5\xfa\x4e\xfe\x80\x93\xfd\x28\xfa\x82\x3c\x0a\x13\x15\x00\x4e\x0e\xe5\xe3\x40\xd7\xfb\x10\x60\x87\x2e\x90\xd6\x9b\xf1\x06\xd7\x4a\xe3\x9f\x1d\xea\x0d\x88\xa6\xad\xb1\x41\x69\x0d\x90\x7a\xbe\x01\x24\x15\xcc\xa7\xdc\x80\x25\xe1\xf6\x1e\xfc\xe5\x21\xbf\xe8\xff\xcd\x40\xc8\xb5\x82\x13\x47\xf0\x5e\xae\x55\xba\x4b\x01\x0f\xa1\x4b\xb9\xbd\xf7\x2e\x7d\xbd\xb6\xa8\x7f\x4a\x95\x89\xe3\x89\x9a\xf4\xc8\x61\xf0\xe5\x34\x82\x7d\xa0\xd0\xfe\xc2\xea\x0e\x4d\x42\x3c\xf9\xa5\xbf\x05\xf5\x22\xf2\x4f\xd4\x39\xfc\xf7\xd8\x08\xd3\xfc\x3d\xf5\x7d\x2f\x71\x38\x98\x5f\x91\x77\xd3\x4f\x99\x9e\x65\x10\xf8\xb1\x2f\x64\x49\x3a\xb6\x47\xb7\xff\xf6\x50\xc0\x0f\xc9\xf3\x7c\x2d\x32\xca\x14\xa5\x2f\x54\xe1\xf5\x78\xab\x75\x1a\x9a\x3a\x4c\x21\x23\x99\x3f\xc8\xe3\x23\xbe\x4b\xde\x2c\x18\xf5\xfd\x71\x4e\x40\x34\xc1\xb9\xbf\x52\x3f\x95\x7a\x93\xe8\xb6\xe6\x6e\x92\x64\xec\x89\xfb\xca\x1d\xc2\x5b\x22\x1e\xdc\xe1\x4d\x7e\x6d\x12\x87\xf3\x72\xe0\x08\x47\x32\x1f\x6c\xfe\x0e\x9b\xbf\xb7\x8a\x25\x42\xda\x64\xa0\xcd\xaf\x5d\xe5\x4b\xd3\x59\x69\x70\x3c\xc3\xfd\xa4\x37\xbb\x0d\x47\xd2\xa1\xe0\x99\xf0\x2a\x42\x06\x93\x2b\xf6\xee\x6e\x74\x5b\x00\x3f\x6a\xfb\x6a\x30\x97\x36\xab\x07\x9e\x90\x94\x87\x71\x42\x77\x3b\x0d\xbb\xbf\x69\x51\xc2\x5e\x76\x5f\xa2\xe1\x43\x2d\xf1\xdb\xc7\x28\x84\xfc\x6c\xf0\x71\x8a\xa2\x46\x78\x94\xe2\x8e\x09\xeb\x0a\xca\xa3\x14\x63\x97\xde\xa3\xd8\x46\x7d\x1c\x1c\x1c\xf4\x0f\x5e\x4f\x4e\xe6\xfe\x7a\x38\x3a\xf0\x11\x9d\x1f\xee\xdc\x8b\xc0\x87\x91\x26\x50\xa2\x5f\x74\xd3\xe1\x99\x7b\x52\xd8\xfa\xe1\xd4\x70\xa2\x24\xdd\x92\xbe\xdc\x64\x50\x61\xdd\x7a\x95\xd2\x3d\x5b\xf7\xe3\x2c\x20\xb8\xc6\x3b\xb2\x36\xd9\x6d\xf5\xaf\xe2\x25\x69\xb9\x8c\x5f\x85\x18\xc3\xcc\x91\x85\x7a\x1f\x08\xca\x97\x33\x4f\x4c\xf8\xde\x55\x7e\x08\xe0\x53\x1f\xf7\x41\xe3\x37\xc8\xc6\x24\x6e\xd8\x3d\x3d\x1d\xc8\xe5\x14\xaa\x86\xe6\xe3\x2b\x76\x2f\x9a\xae\x09\x12\x98\xa8\x82\x80\x36\x60\x95\x4b\xf7\xe1\xb5\x28\x8f\x83\x21\x49\x4d\x38\x23\xd4\x21\x98\xeb\xa9\x3e\x18\x9a\xcd\x85\xa9\x30\x7c\xf2\x30\x19\xac\x94\xad\xe8\xcd\xa3\x33\xe8\xae\x2d\xa2\xa8\xe7\x58\x2e\x8e\x47\xb0\xde\x2c\x21\x97\x9d\xc1\xe3\x68\xc1\x06\xf0\x4e\x6b\x94\xb6\xde\x78\x94\xb9\xf0\xa2\xc6\x5d\x43\x48\x83\xe3\xa2\x69\x37\x94\x3f\x13\x37\xe6\xcb\xd9\x24\x8e\xd6\x96\xee\x4d\x63\x18\xe8\xf6\x27\xb7\x40\x9e\xcb\x28\x2c\x60\xad\xf4\x9e\xe8\x21\xd1\xce\x42\xd1\xbb\xe3\xf4\x2e\x8a\x15\x0d\xc2\x8a\x6e\x5f\x58\x38\x29\x54\x7c\x69\x9a\x63\x20\xf1\x2e\x80\x9e\x8d\xd4\x69\x36\x6b\x05\x14\xdf\x5a\xac\x30\x6c\xdb\x41\x06\x8c\x61\x3a\xb4\xf1\x76\x27\x91\xd3\x51\x42\xc2\x2b\xe0\x15\x93\xbf\x9f\xee\x25\xd9\x50\xcd\x79\x05\xbf\x9f\x42\x9b\xfb\x80\x9e\xad\xa9\xdd\x05\x17\x1e\xf3\x95\xa2\x9e\x2f\x8c\xa7\xb2\xb7\x3a\x38\xd4\x5b\xe9\xd5\x7d\xbe\x91\xfe\x33\xb0\x31\xe0\xef\xcb\xc3\x60\x64\x9b\xfb\xce\x92\xf0\x2a\x83\xd8\xbf\x69\xc5\x19\xb4\xb9\xcf\xf0\xdc\xaf\xe5\xee\xf9\x6b\x18\x0e\xe8\xe4\x44\x06\xc1\xfc\x3d\x31\xf8\xd7\x30\xe3\x21\x0e\xc0\x78\x8a\xf8\xd5\xbc\x4d\xa6\x19\xe8\xbc\x58\xcd\x91\xdc\x18\x70\xcc\x52\xfe\xdf\x2d\xf5\x0f\x76\x43\x95\x37\x96\xcd\xbb\xe8\xce\x71\x07\xec\x9d\xb1\xee\xce\x25\x8d\xed\x9d\x96\x8c\xc1\x90\x85\x84\xff\xa2\x47\x43\x37\xfe\x64\xb0\xae\x15\xb3\xff\xf8\xff\xc4\xc1\xe4\x57\x7d\xe8\x5c\x4c\x79\x95\xf6\xea\xa4\x3f\x01\xa9\x9e\x8e\xf7\xeb\x60\x2e\x8e\x9f\x88\xf6\x9e\x68\x9f\x83\x51\xd4\x4f\x86\x28\xea\xe7\x20\x8c\xa9\x96\xc1\x81\x3b\xc9\x21\xa0\xbf\x06\x8e\x67\xa2\x0d\x29\xfc\x08\xe0\x04\xb4\x3f\x71\x67\xd0\x2a\x55\xa7\xd1\x36\xfa\xcf\x00\x87\x7b\x25\x6e\x70\x19\x00\x00"
+
+func metricsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_metricsTpl,
+		"metrics.tpl",
+	)
+}
+
+func metricsTpl() (*asset, error) {
+	bytes, err := metricsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "metrics.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _repositoryTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5c\xdd\x6f\xdb\xc6\x96\x7f\xa6\xfe\x8a\x53\x21\xd7\x90\x52\x86\xf6\x05\x16\xfb\xe0\x5e\x15\xe8\xda\x49\xe1\x8d\xeb\xb6\x76\xb2\xc0\xc2\x30\x8a\xb1\x78\x68\x0f\x4c\xcd\x28\x9c\x51\x6c\x41\xe1\xff\xbe\x38\xf3\xc5\x0f\x49\x14\xed\xc4\x4e\xf7\x3e\xf4\xc6\x22\x67\xce\x9c\xf9\x9d\xef\x33\xc3\xbb\xbf\x0f\x1f\x6e\xb9\x82\x8c\xe7\x08\xf7\x4c\xc1\x0d\x0a\x2c\x98\xc6\x14\xae\x97\x70\x23\xdf\xa4\x4c\xbe\x99\xca\x14\xdf\xdc\xa0\x48\x06\xfb\xfb\xf0\xbf\x72\x01\x53\x26\x60\x26\x53\x9e\x2d\x81\x6b\xd0\x12\xae\x11\x66\xb2\x40\x50\x0b\xae\xd9\x75\x8e\xc9\x60\x30\x67\xd3\x3b\x76\x83\xb0\x5a\x41\xf2\xc7\xdd\x0d\x94\xe5\x60\xc0\x67\x73\x59\x68\x18\x0d\xa2\xe1\x54\x0a\x8d\x0f\x7a\x38\x88\x86\x29\xd3\xec\x9a\x29\xdc\x57\x9f\x72\xfa\x8d\x45\x21\x0b\x45\x7f\x65\x33\x33\x40\xe5\x7c\x8a\xe6\x81\x5a\x8a\xe9\x70\x00\x00\xb0\x5a\xbd\x01\x9e\x81\x2c\x60\x24\x10\x92\x0f\x7c\x86\xef\x38\xe6\xa9\x4a\x8e\x0a\x64\x1a\xe9\x01\x0c\x87\xe3\xb5\xd7\x1f\xe7\x69\xfd\x75\x59\x0e\xa2\xa1\xe6\x33\xac\xc8\xa2\x48\x89\xdb\xf1\x80\xb6\x4b\xec\x7f\xa0\x2d\x7d\x9c\xcf\xb1\x38\x62\x33\xcc\x4f\x52\x14\x1a\xca\xf2\x1c\xe7\x52\x71\x2d\x8b\x25\xa8\x39\x4e\x79\xc6\x51\x81\xbe\x45\x90\x73\x82\x90\x4b\xa1\x40\x0a\xf3\x24\x50\x81\xb2\x04\x83\x50\x0c\xf7\xb7\x7c\x7a\x0b\xac\x40\xe0\xb3\x79\x8e\x33\x14\x1a\x53\x5a\xf2\x7a\xd9\xb5\xea\x31\x93\xc0\x84\x11\xcf\x3b\x76\x87\xfd\xf8\xcb\x64\x01\x0b\x41\xb2\x42\xa5\x55\x32\xd0\xcb\x39\x42\xbf\xa9\x5c\x68\x2c\x32\x36\x45\x58\x0d\xa2\x13\xa1\xb0\xd0\xa3\xa9\x7e\x00\x27\xc0\xe4\xc8\xfe\x1b\xc3\x67\x96\x2f\x50\xc1\x8c\xcd\x2f\x95\x2e\xb8\xb8\xb9\x62\x62\x39\x86\x51\xce\x94\xb6\xf3\x4e\x8e\x81\x0b\xfd\x9f\xff\x11\x03\x16\x05\xfd\x27\x8b\xb1\xa7\xf9\x1b\x13\xcb\x0e\xba\xa7\x5c\x69\xb8\xbc\x5a\x23\x5e\x27\xf4\x2b\x6e\xe1\x6c\x2a\x45\xaa\x20\x49\x92\x8e\x1d\x1f\x49\x91\x8e\x61\xf4\xba\x63\xc8\x5b\xa1\xb9\x5e\xc6\x61\xbd\x77\xbc\x50\x2f\xbb\xe2\x91\x5c\x88\xaf\x5f\x51\x4b\xcd\x72\x92\x44\x53\x0e\xa7\x7c\xdb\x6e\x72\x3e\xe3\x3a\x06\x99\x65\x0a\xb5\x9d\xf8\x88\xe5\x2e\xaf\x1e\xb3\xc5\x5f\xf2\xbc\x83\x89\x67\x5e\xdc\xfa\x86\x47\xa8\xf7\xd3\x70\x6f\x5b\xc0\x31\xe6\xb8\x6d\xd9\x6f\x41\xff\xcf\x05\x16\xcb\xd1\x27\xfa\x5f\xb0\xd6\x13\x03\x2b\x6e\x0c\x59\x6b\xa3\xaf\xd5\xa7\x3c\x39\x97\xf7\xaa\xc2\xc2\x4c\x3a\xc7\x79\xce\xa7\xec\xb1\x73\xdf\x3e\xe0\xb4\x73\x8e\x99\x82\x6a\x91\xeb\x6a\x12\xb9\xde\x82\x89\x1b\x84\xe4\x1c\x33\x2c\x50\x4c\x51\x91\x23\x8e\x48\x82\xbf\xa2\x3e\x63\x33\x84\xb2\xdc\x8c\xd3\x6a\x05\xaf\x2c\x3e\xa7\xf2\xbe\x85\x8f\xd5\x31\x78\x5d\x8d\x59\xc7\xd0\x8e\xf1\xf6\xf8\x07\x2b\x50\xe8\xee\xb1\x75\xce\x21\x39\x95\x2c\x7d\x32\x83\xce\xbb\xf5\x63\x90\x7c\x20\xad\xf8\x1e\x97\x1f\xc8\x8d\x97\xe5\xd5\x93\x78\x0e\x81\x6e\x23\xf2\xe9\x7f\x2d\x03\xf6\xc4\xde\x0b\x80\xbf\xdb\xd1\x1c\xdd\xf2\x3c\xdd\x61\x05\xce\xe2\x3b\x87\x76\x80\x51\x0e\x06\x9f\x59\x41\x29\xca\x5f\x3d\x03\xe4\xa4\xdb\x87\x1f\x33\x39\x1e\x09\x9e\x8f\x1f\x47\xb1\x77\x6c\x77\xc4\x6d\xb6\xd2\x3f\x23\xe0\x0a\x98\x00\x2e\xde\xcc\x70\x46\xbf\x9f\x96\x47\xec\xef\xc3\x89\x06\x24\xdf\xc8\xb4\xcb\x7e\xc8\x63\x71\x9b\xfd\xb0\x1b\xc6\x85\xd2\xe6\xb1\xd2\xb2\xc0\x14\x90\xa0\xa7\x44\x29\xe7\x77\x68\x5e\x5c\xfc\x79\x0a\x32\xeb\x62\xe0\x98\xc9\x18\xf0\x61\x8a\x73\x0d\x99\x2c\x68\xa3\x94\xb1\x99\xa4\x0f\xa6\x32\x5f\xcc\x84\x6a\xe4\x53\x37\xc2\xac\x75\xbd\x6c\x31\x14\x9b\xcc\xe9\xbf\x2f\x7e\x3f\x6b\x3c\xb4\x33\x0b\xd4\x8b\x42\xc0\xdb\xa2\x20\x14\x3f\x0a\xb5\x98\x53\xb6\x8a\xa9\xd9\xa6\x71\x87\x31\xd4\xbd\xa2\x21\x46\xae\xae\x6b\xaa\xcb\x53\x93\x73\xcc\x29\xab\x36\x10\x1b\xaf\x66\x32\x6f\x84\x82\x9e\x73\x29\x60\x86\xfa\x56\xa6\x26\x13\xd7\x58\x18\xc6\x0b\x9c\xca\x22\x55\x84\x0e\xfd\xcc\xd8\x1d\x8d\x77\xc2\xe0\x18\x5e\x18\x1a\x98\xda\xc4\xb2\x81\x44\xce\xc5\x9d\x01\xc2\xa5\xb2\xd6\xbf\xbe\xe2\x31\xbc\x32\x83\xe1\x70\xb2\xce\xda\x6a\x45\x99\xf5\x2b\x0e\x65\x69\xfc\xaa\xb5\x8c\x0b\xd4\xe4\x9e\xcc\xb4\xdd\x9a\x12\xa6\x59\xc8\xb7\x02\x04\x0b\xa1\x79\x4e\xbb\x10\xc9\xa0\x66\x86\x26\x41\xed\xaf\xcd\x4a\x17\x8b\xa9\xa6\x0c\x75\xb6\xa0\x34\x1e\x80\x2a\x85\xe4\xb7\x85\xc6\x87\x41\xe4\x71\xec\x93\x0c\x58\x67\x40\x12\xfb\xa3\xe0\x33\x56\x58\x2f\x68\x72\xd8\x63\xb0\xc1\xb5\xc3\x79\xb6\x64\x1c\x85\xe5\xd6\xfd\x22\x61\x05\xfd\xcd\xbc\xed\xa4\xf6\xf7\xe1\x0c\xef\xfb\x23\x64\x25\xa0\x80\xb5\xd4\x88\xb0\x93\x94\x12\xc0\x54\xce\x6b\x3a\xe5\xed\x34\x19\x64\x0b\x31\x7d\xd4\x5a\xa3\x60\xe3\x49\x92\xec\x46\x7c\xfc\x08\x14\x48\xc2\x19\x29\xed\x5e\xef\x29\xab\x72\x10\x91\xdb\xfa\x2b\xb6\xbe\x67\x49\xd3\xad\xc0\x02\x9f\xab\x41\xe4\x94\x84\x5e\xbe\x46\xa7\x09\x51\x96\x78\xd5\x99\x00\x9b\xcf\x51\xa4\xa3\xf0\x28\x86\x3d\xfb\xd7\x78\x10\x6d\xd4\x99\x88\x67\xc0\xd3\x18\xe4\x1d\x11\x25\xd4\x4f\x84\x1e\xd1\xbf\xa6\x12\x1d\xb9\xe9\x31\x0c\x57\xab\xfa\xcc\xe1\x78\xfc\x13\x4d\xda\xdb\x03\x9e\xc2\xcf\x90\x25\x4e\xfb\x88\xcb\x28\xfc\x9a\x00\x4f\x07\x51\x54\x0e\xa2\xba\x66\xd0\x6f\x67\x6c\x99\x53\x13\x5b\x5f\x55\x45\xa6\xea\xe7\xeb\x9d\xe0\x47\xd9\x23\xc4\x33\x86\x6f\x5f\x20\x92\xc8\x79\x06\x39\x8a\x91\x25\x30\x86\xc9\x04\x0e\xe8\xb1\xdf\x69\x9d\x80\x0b\xea\x2a\x39\xc3\xfb\xd1\x70\xce\x0a\x36\xf3\x0b\x4f\x99\x10\x52\x53\xaf\x02\x67\x73\xbd\x1c\x8e\x0d\x5a\x59\x32\x5b\x24\xa7\x72\x7a\x37\x1a\x0f\xa2\x94\x12\x4f\x30\x8f\x3e\x8a\xdc\x3d\xf4\x78\x26\xdc\x6e\xce\xb1\xd1\x80\x97\xca\xd7\x47\x43\x1c\x37\xbc\xbc\x89\x5c\x86\x18\xa6\xc0\xf2\x9c\x7a\x1b\x42\x0a\xfc\x1a\x41\x7c\x75\x55\xdd\x46\x9f\x52\xc1\x35\x01\x18\x1c\xd7\x47\xfd\x0c\xbf\xb1\x87\x0e\x76\x4f\xa9\xac\xac\xcb\x31\x9b\xe9\xe4\x2d\x2d\x9b\x8d\x86\x05\x4e\x91\x7f\xc6\x14\xfe\x91\x02\x35\x88\x6c\x02\x80\x29\x79\x2a\x42\x6d\xc6\x1e\xf8\x6c\x31\xa3\xd7\x39\xd1\x19\xc6\xad\xe5\xe3\x3e\xab\xf7\x57\x01\xb2\x74\x65\x0c\xd9\x3b\x80\xce\x48\x61\x06\xda\xbf\x9b\xe1\xc2\xb9\x22\xa7\x92\xc1\x15\x05\xbe\x0d\x1e\xdb\xf4\x3d\x22\xb3\x98\x3c\x4a\xc3\xa3\x12\x30\x57\xa6\x79\x13\x45\xe4\x03\x0d\x85\x35\x5d\xb6\x8e\x84\x67\xe6\xfd\x0f\x13\x10\x3c\xf7\xfe\xc6\xef\x7d\xe2\x35\x75\x10\x6d\x71\x78\x75\xe7\xe4\xf7\xde\xf4\x4e\x41\xd4\x58\x14\xd6\x79\x55\xfe\x4a\xf0\xdc\x99\x94\xe5\xcd\x04\x26\xa4\x88\x25\xf0\xde\xad\xed\xa3\x93\x81\x4b\x3d\xcd\x32\x1a\x3b\x7f\x8a\x37\xaa\x22\xc5\x5e\xc7\x92\x36\xb8\xad\x5c\x86\x40\x58\x5d\xdc\xb2\xc2\xc2\xc0\x33\xf8\xcb\x07\x06\xbb\x95\xcb\x0e\x4a\x66\xde\x7b\x5c\x5e\xfd\x04\x3f\xc8\xbb\xed\x8e\xef\x6d\x51\xf8\xa1\xe7\xf8\x69\xc1\x0b\x4c\x0d\xbe\x75\x01\x38\x09\x4f\x60\x7a\x8b\xd3\xbb\x23\x93\x3b\xff\x21\x73\x3e\xe5\xa8\x46\x1d\x3c\x98\xc7\x54\x03\xc6\xd0\x91\xd3\x34\xe9\x79\x2d\x8f\x21\x63\xb9\xc2\xf1\x4f\x6d\xe5\x6a\x7a\x0f\x7a\x69\xe0\xe0\xd4\x84\xf9\x1f\x03\xcb\xb7\x60\x49\x28\x5d\x30\x2e\x74\x8d\x1f\x5d\x2c\xba\xd9\x71\x56\x9a\x51\xa0\xae\xf2\x85\x8e\x65\x4c\x48\x57\xde\x78\x3f\xb3\xbc\x25\x5e\x43\xe9\xca\xc4\x75\x1a\x53\xed\x57\xa1\x7e\x17\x52\x02\x9f\x11\x98\xd1\x86\xdd\x75\x2e\x2b\x3e\x8d\xfd\x38\x1b\xfa\x96\xed\xf0\xe9\xa2\xa0\x01\xc4\xbd\x29\xb3\xce\xe4\xfd\x68\x43\xc7\x80\x67\xd0\xb5\xd4\x36\x45\x37\xd9\xce\xe6\x49\x65\x39\xac\xe9\xf8\x46\x64\xba\x67\xc7\xe0\x78\xb7\x25\x0c\x7e\xda\x34\x96\x2a\x8b\x21\x17\x7a\x08\x65\x99\x7c\x14\xfc\x61\x34\x0e\xd5\xca\x78\xcd\x60\x36\xef\xb4\x81\x5a\xdf\x9d\xd6\x26\x3d\x61\xa7\xcd\xd9\xdd\x3b\x75\x63\x9f\xb4\xd3\x86\x4b\xdf\xb6\xab\x6a\x4c\x7d\x1f\xf5\xd1\xeb\xf9\x6e\x63\x63\xeb\xe9\x2e\x11\xe8\x40\xa2\x36\x21\x0e\xa1\xf5\xc7\x7f\xba\xc8\x55\x0e\x76\x65\xdb\x3d\x56\x6f\x78\x7e\x9b\x5e\xb7\x20\x0a\x56\x9b\x25\xc6\x7f\x7e\x14\xfc\xd3\x02\x47\x43\x3b\x6b\x18\xbb\x28\x15\x93\x4b\xd9\xe6\x5f\xe0\xc0\xc4\x93\x41\xb4\x1d\xf2\x06\x23\xad\x02\xa0\x1d\x62\xdd\xb0\x35\x56\xbb\x4b\x97\x50\xb9\x38\x5f\x62\xe3\x6e\x6d\x4f\xa1\x5e\x7c\x5b\x14\xc7\x0b\xd3\x04\xd6\xf8\x1e\x97\xc4\x6e\x95\xb4\xc2\x2d\x53\xb5\x78\x4c\xd1\x99\xc1\xc2\x12\xe0\x22\xc5\x07\xf3\x44\x48\x7d\x8b\x85\x9f\xa2\x6f\x99\x00\x85\x79\x66\xda\x2a\x67\x1f\x4f\x4f\xfd\x6c\x4a\x80\x29\x41\x4f\xfd\x7a\xae\x49\xc4\x05\xfc\xb6\xbc\xf8\xf3\xf4\x69\xe1\xbe\x2e\xa8\x70\x30\x17\xda\xd2\x5e\x60\xc4\x10\xf4\xa9\x55\x4d\x1a\x40\x92\xa0\x10\x61\xf6\x18\xfb\x16\x54\xaf\x40\x61\x55\xe6\x84\x26\xba\xc2\xd3\xc5\x1a\x0b\x52\x20\x51\xc9\xcf\xc7\x0b\x3b\x60\x42\x21\x23\xcf\x5c\x28\xa0\x4e\x2c\x17\x0b\xf4\xc1\x20\x0a\xd8\x11\x33\x14\xe6\x06\x51\x58\xc1\xb2\x59\x2d\xe1\xd9\xb6\xa4\x8c\x10\xbc\xed\x34\x6d\xc6\x0e\x24\x53\x33\x36\x4e\x03\x4e\xd4\xd9\x22\xcf\x6d\x12\x35\x86\x2f\x5f\xe0\x07\x7a\xfa\xf6\xd3\x82\xb9\x87\x71\x8d\x8e\xe1\x3c\x90\x19\xbb\x05\x6b\xbc\xd2\xa2\xb9\x32\xbb\x88\xa2\xeb\x02\xd9\x9d\xf9\xb3\x0c\xdb\xe2\x59\xa5\x15\x6e\xba\xb3\xa6\x3d\x53\x30\xac\x0c\xda\x87\xd0\x21\xc0\x5a\xba\xf0\xbb\x57\x83\xc3\xea\xa8\x36\x86\xf7\x5c\xa4\x87\x6d\x7d\x8f\xc1\x88\xea\xd0\x8b\xfa\xc8\x40\xa1\x0e\xdd\x66\x54\x60\x71\x4b\x2a\xfb\x2b\xbe\x54\xe5\xfd\xb2\xa7\x9f\x24\x84\x9c\x2b\x77\x84\x48\x5a\x93\x64\x5c\xa4\xa3\x7f\xc6\xe4\xdf\xa8\xb9\xaa\xc6\xc1\x5f\x3a\x1f\xf8\xe5\x8b\xa9\x6a\xf2\x4d\x25\x24\x0d\xa8\xfc\xa2\x7b\x46\x23\x2f\x0f\xae\xe2\x1a\x9e\xe6\xcc\xf5\x85\x10\x7d\xe9\xf3\x5d\x82\xa3\xc3\x73\xd4\x06\x1b\x33\x4d\x9c\xc4\x1d\x37\x49\x92\xac\x21\xbe\x0d\x5f\x9e\xc1\xce\x85\x60\xb2\x99\xc6\x57\x1a\xdc\xd0\xa0\x3a\xac\x99\xdb\x99\xd4\xef\xe4\x42\xa4\x0d\x0b\xda\xc9\x5e\x5d\x29\xcc\xb1\xf8\x0b\x29\xc5\x33\x1e\xc1\x6f\xb3\xa9\x83\x86\x4d\x39\x80\x82\x25\x99\xd1\x0e\x88\x53\xfe\x62\xc6\xf1\x77\xb8\x2d\xe0\x9b\x53\xd4\xc9\x81\x7f\x51\x93\x84\x5c\x8c\xf9\xd5\xbf\xf7\x64\xd8\x85\x49\x9f\xf1\xde\x76\xdc\xd6\xfe\xe5\x3c\x98\xfb\x39\x81\x83\xba\x06\x3b\xe1\x35\xd0\x08\x52\xb4\xe2\xfa\x25\xcf\x5f\x48\x5a\xdf\xf1\x56\x05\xac\xb6\x40\x52\xe9\xb4\x45\x83\xe0\x0a\x69\x67\xf3\x30\xc2\xa7\x42\xfa\x96\x69\x98\x21\xea\xf6\x41\xa3\x2c\x52\x74\x27\x7e\xab\x55\x2b\xa5\x6e\x26\xfc\x90\xa2\x9a\xa2\xa0\x3e\x22\x55\x7d\xd4\x1a\x2b\x4b\xdb\x12\xe2\x52\x84\xf2\x28\x26\x8e\x68\x31\xa9\xb4\x03\xc9\x33\x11\x34\xee\x67\x38\x78\x9a\x34\xd6\x15\xa3\x2e\x81\xcb\xab\x6f\x2f\x80\xde\x1d\xce\x9a\xef\x99\x31\x3d\xbd\x1d\x6d\x0e\xe5\xdb\x02\xcb\xa6\x82\xc6\xde\xde\x4b\x2e\x64\xa1\x2f\xcc\xe9\xe1\xbb\x85\x98\xba\xdc\x52\xc5\x40\x00\x8e\x58\x0c\xd7\xbd\xd2\x6f\x2e\x9a\x1d\x63\x76\x87\x47\x72\x36\x67\x05\xd6\x6a\xbd\xeb\x0d\x65\x5e\x3d\x1f\x65\x9b\xca\xc0\x41\x54\xb6\x9a\x1c\x6b\x8d\xcf\xcb\x19\x17\x23\x6f\xc9\xe4\x80\xdd\xf3\xf1\xf8\xf0\xaa\xe6\x89\x7e\x0e\x99\x4d\x7b\xfe\x21\x11\x70\x82\x6f\xcc\xbf\x32\x9e\x83\xfc\x39\x85\xf6\x19\xbb\xc3\x9e\x22\x3e\x68\x11\x0a\xad\x2b\x57\x6d\x85\x64\xdf\x8d\x30\x8c\x55\xe7\x60\xaf\xed\x63\x2a\x7e\x69\xed\x50\x2a\x12\x27\x31\xec\xd9\x81\xe3\xba\x5b\xb3\x6f\xaa\xd8\x6b\xb4\x24\x98\x6d\xed\xa0\x7f\x97\xcd\xae\x56\x8d\xa6\x68\x75\x0a\x6f\x3a\x97\xc6\xc0\x95\x79\x77\x87\x4b\x5b\x07\x76\xc0\x71\xcc\x64\x30\xdd\xa7\xd9\x64\x4d\xdb\x9f\xc5\x02\x25\xc1\x7d\x86\xf7\x3b\x28\xab\x51\x2d\x99\xdb\xd8\x38\x96\xc6\x3f\x9b\x47\xc9\x7b\x5c\xba\x7b\x39\xdb\xb2\xb5\x3e\x3d\xe1\xda\x09\xf6\x2f\x5a\x17\xfe\xe4\xda\xae\x7d\xa2\xcc\x75\x09\xdf\x99\x40\x31\xb2\x0c\xb8\x75\xe9\x25\x05\x05\x35\x86\x1f\x36\x65\xf4\xeb\x27\xfe\x1b\x97\xf7\x7f\xd3\xd5\x1b\xaf\x38\x7d\x10\xde\xaa\xee\xcd\xf2\x79\xe3\x0e\xfd\x16\x4d\x13\xd3\xb4\xca\xc2\xa5\x92\x61\x38\xc0\x6d\x6c\xd6\x7b\xbf\xbd\xbd\x7a\xb9\x6b\x17\xaa\x8f\x8b\xe1\x75\x63\x9e\xab\x78\x6b\xc5\x7a\xfb\xd4\xb6\xf9\xc3\xb3\x1e\xcc\xd1\x3d\xf0\xdb\x6c\x18\x64\x78\x57\xd9\xa4\x6d\xff\xbd\x50\x6a\xf1\x5d\xee\x4c\xfa\xcc\x0f\x85\x0b\x50\xa4\xff\x36\xfd\xdb\x74\x6e\xe6\x3b\xe6\xdf\xed\x00\x64\xe7\x81\xc3\xcb\x9e\x7f\xec\x3e\x8f\xd9\xe8\x7a\xda\xad\xe0\x0e\xe6\xbc\xd3\xa9\x8e\x3c\x9c\xba\x1a\x81\x36\xfc\x92\x55\xa0\x75\xbf\xf0\x7c\x39\xcb\x20\x7a\x44\x63\xbf\xc7\x69\x48\x8f\x90\xbb\x30\x74\xd3\x66\xcc\xa5\x79\xd5\x51\x4f\x35\xd1\x99\x4d\xc7\x41\xd1\x9e\xa3\xd7\xef\xa8\x28\xb4\x9c\x43\xab\xaa\xf7\xee\xa3\x2d\xcb\x3e\xff\xe9\x44\x03\xe1\xad\x9d\x77\x4b\x6b\x18\x43\xc5\x9a\xf3\x91\xeb\x68\x34\x35\x30\x9c\x40\x47\x4e\x20\x30\x01\x47\x63\x10\x45\x46\x4d\x7f\xfc\xb1\xe6\x68\x9d\x6f\xb5\x77\xb5\x5f\xc8\xb7\x3e\xef\xc5\xf0\x2d\x4e\xb4\x65\x27\xcf\x6c\x86\x55\xa0\x9e\x80\xab\x14\xec\xae\x4d\x95\x90\x25\xcd\x3a\xc1\x49\x6a\x77\x62\x30\x86\x6b\x29\xeb\x8b\x79\xe2\x54\xf9\xd2\xa5\xd4\xd1\xfa\x59\x48\x59\x75\x57\x4c\xc0\x19\x35\x32\xeb\x10\x60\xbb\x2e\xfc\x51\xf4\xbd\x40\xdd\xc1\x5e\x25\x5e\x73\x39\x53\x6d\xb8\xd9\xb9\xf4\x65\xef\xfa\x57\x43\x50\x20\x0b\xf7\x5a\xdb\x57\x47\xd7\x75\xec\xd5\x6e\x2e\xc6\xbd\xf9\x1d\x15\x8f\xbb\xb1\xd8\xb3\xf0\xcc\x92\x8e\xe8\x45\x68\x99\xfa\x69\x2e\x9d\xfd\xed\x18\xec\x00\xac\xd7\x23\xee\x0e\x6c\x6f\x8c\x4d\xe9\x2f\x8b\x4d\x97\x55\xed\xe1\x17\xdd\xd3\x52\x5b\xae\x66\xf5\x42\xbc\xd7\x1e\x46\xf4\x2d\x42\x6f\xb0\x1f\x57\xec\xd3\x21\xce\x6e\xd8\xdb\xf6\x4a\x3f\xe3\xc6\x25\xad\x7f\xdc\x1f\xae\x83\x58\xc3\xd8\xc0\xa4\xbd\x04\xae\x97\xbd\x75\x6d\xb8\xa9\x72\x68\xa4\xbd\x3b\xf9\xf7\xf6\x5a\x0b\x23\x9b\xbe\x73\x08\x66\xdb\xfc\xc8\xa4\xe5\xe0\x7b\x88\xf5\x2b\x74\xa1\x5a\x76\xb3\xab\xaf\x68\x6d\x6d\xd6\x3f\xd3\xb7\x2d\xb0\x0a\x89\x52\x62\x8f\xfc\x7c\x2e\xb8\x5a\xd5\x9e\xac\x29\x09\x41\xdf\xce\xe7\x3c\x9d\x0b\xcc\xb3\x00\x3f\x94\x75\x91\x86\x13\x0d\xa2\x7e\x8e\x19\xc5\x2f\xfa\xe8\xc4\x7d\xee\x42\x89\x81\x4f\xbd\x6c\xe3\x8e\xd4\x61\x2e\x6b\x91\xa7\xbd\xc1\x6e\x0b\xeb\xdb\xd6\x72\xcf\x68\xad\xc7\xf0\x58\xbf\xac\xdd\xfa\x46\xe8\x45\xf5\xab\xb6\xee\xff\x83\x6f\x93\x48\x99\xee\x70\xa9\x42\x27\x6c\x03\x51\x7b\xdf\x7f\x55\xda\xee\xd7\x2e\xf6\x6b\x6d\xb1\x1e\xa6\x14\xd2\xf0\x5d\x64\xfd\x9d\x97\x1e\x34\x6d\x77\xc6\xb5\xbc\x2a\xb3\xf9\xf2\xa5\x69\x47\x21\xff\xdd\xd8\x30\x20\x50\x3c\x10\x84\x2c\x4c\xdc\x77\x0f\xab\x72\xe5\x0f\xcf\xc2\x35\xd5\x6d\xc8\x3d\x42\x1c\x04\x2d\xad\x39\x1e\x87\x3c\xd1\xfc\x6c\xa7\x89\xcd\x16\x44\xb4\xc3\xd4\xe7\xa6\x13\x96\x7d\x0f\x33\xae\x7b\xa3\xc6\x41\x1b\x71\x95\xb8\x33\x92\x18\x0e\x7a\x13\xac\x94\x6a\x07\xb7\x6d\xd5\xca\x6b\xea\x13\xaa\xea\x20\xdf\x73\xcc\x9c\x88\x43\xf5\xec\x45\xdb\x7a\x0f\x93\xde\x8b\xb7\xef\x28\x34\x84\xb6\x33\x4c\xba\xcf\x01\x83\x27\xe3\xea\xbb\x44\xca\x7f\xf3\x2f\x11\x49\xd4\xe1\x1e\x0e\xcd\x35\xb7\x60\xa1\xfc\x56\x26\x55\x63\x65\x1d\x9e\xaf\xb5\xa8\xa0\x57\x73\x99\xf8\xd3\xe1\x16\x90\xb1\x6f\x68\x5e\x5e\xb5\xd8\xd9\x0c\xe2\x0a\x68\x81\xa4\x1e\x62\xe9\x6f\x8f\xca\xb8\x74\x52\xa1\x76\x39\xfd\xd7\x4c\xf7\xc2\x67\x7b\x2d\x15\xdd\xad\x6c\x31\xfd\xdf\x5a\xf8\x1a\x62\x3d\x11\x5d\x57\xe0\xdd\x24\xc7\xf0\x84\xaf\xb0\x6b\x67\xa7\xdb\xba\xe9\xb5\x6d\xfa\xcf\x12\xff\x3e\xbb\x75\x1c\x3d\xd3\xa6\xcd\xa7\x97\x7f\x8b\xcd\x3e\xe1\x7b\xf7\x5e\xbb\xfc\xbf\x01\x00\xc5\xd2\xa2\x95\x9e\x45\x00\x00"
+
+func repositoryTplBytes() ([]byte, error) {
+	return bindataRead(
+		_repositoryTpl,
+		"repository.tpl",
+	)
+}
+
+func repositoryTpl() (*asset, error) {
+	bytes, err := repositoryTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repository.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tableTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7f\x73\xdb\x36\xd2\xf0\xdf\xd2\xa7\xd8\x6a\x9a\x0c\x95\x97\xa1\xd3\x99\x9b\xfb\xc3\x57\x75\x5e\xc7\x49\x5b\xbf\xe7\xa4\xbd\xfc\xe8\xcd\x3b\x1e\x5f\x8f\x12\x21\x1b\x8f\x29\x52\x21\x28\x3b\x1a\x45\xdf\xfd\x99\x5d\x2c\x40\x80\xa4\x28\xca\x49\xda\xdc\xf3\xe4\xda\xb9\xca\x20\xb0\x58\x2c\xf6\x27\xb0\x00\x8e\x8e\xe0\xcd\xb5\x54\x30\x97\xa9\x80\xbb\x58\xc1\x95\xc8\x44\x11\x97\x22\x81\xe9\x1a\xae\xf2\xc7\x49\x9c\x3f\x9e\xe5\x89\x78\x7c\x25\xb2\x08\x86\x47\x47\xf0\xff\xf3\x15\xcc\xe2\x0c\x16\x79\x22\xe7\x6b\x90\x25\x94\x39\x4c\x05\x2c\xf2\x42\x80\x5a\xc9\x32\x9e\xa6\x22\x82\xe1\x70\x19\xcf\x6e\xe2\x2b\x01\x9b\x0d\x44\xbf\xde\x5c\xc1\x76\x3b\x1c\xca\xc5\x32\x2f\x4a\x08\x86\x83\xd1\x2c\xcf\x4a\xf1\xbe\x1c\x0d\x07\x23\x51\x14\x79\xa1\x46\x43\x00\x80\xd1\x7c\x51\xea\x5f\x9b\x4d\x11\x67\x57\x02\xa2\x33\x6a\xa4\xb6\x5b\x2a\x1e\x6d\x36\xd1\x76\x6b\xaa\x88\x2c\xe1\xf2\xe1\x60\x74\x25\xcb\xeb\xd5\x34\x9a\xe5\x8b\xa3\xeb\x55\x9c\x25\xab\xa3\xab\xfc\xb1\x7a\x97\x4e\x57\x32\x4d\x44\x31\x1a\x8e\x87\xc3\x59\x9e\xa9\x12\x02\x38\x3a\x22\xc4\xde\x20\xb6\xe7\xf9\x9d\x28\x4e\xe3\x85\x48\xcf\x12\x91\x95\xb0\xdd\x52\xf1\xcb\x78\x21\x40\x2d\xc5\x4c\xce\xa5\x50\x50\x5e\x0b\xa0\xc1\x41\x16\x2f\x44\xc4\x08\x30\x88\xb7\xcb\xe5\x4e\x10\x13\x18\xd9\x7a\x60\x50\x3f\x3a\xea\x6a\xfc\x2c\x2e\xe3\x69\xac\xea\xdd\x27\xa6\x38\x9f\x57\xe8\x84\x70\x77\x9d\x2b\x01\xb3\x3c\xcb\xc4\xac\x94\x79\x06\x52\x41\x21\xae\xa4\x2a\x45\xa1\x67\xf2\x2c\x93\x25\xe4\x05\xbc\xe2\xd2\xbd\xd8\x5b\x04\x18\x79\xfb\xb7\x83\xff\x8b\xf8\x7d\x07\x84\x73\xb9\x90\x65\x0d\xff\x94\xca\xf2\x39\xc8\x4c\x89\xa2\x84\x38\x4b\x40\x89\x54\xcc\x4a\xc8\x97\xc8\x77\x32\xcf\x54\x34\x1c\xf4\x81\x2c\xb3\x12\x26\xf0\xdd\x93\x27\x4f\x70\x5a\x6f\xe3\x02\xb9\xaa\x63\x4a\x4f\x52\x19\x2b\xe0\xff\x75\x40\xa7\x7a\x9d\x90\x7e\x94\x22\x4d\x0c\xa8\x8b\x4b\x55\x16\x32\xbb\x1a\x0e\xba\x39\xea\x6d\x26\xdf\xad\xc4\x59\x96\x88\xf7\x42\xc1\x22\x5e\xea\x19\x5d\x51\x31\x48\x2e\x2f\x73\x2c\x95\x05\xcc\xf2\x74\xb5\x20\x5a\xf4\x86\x39\x41\xa8\x17\x1a\x9b\x4b\x83\xd6\x86\x27\xfa\x31\x68\x61\xfa\x16\x79\x37\x84\x6f\x19\x3e\x1c\x4f\x20\xf2\xc1\xb0\x38\xb1\xa8\xe9\x06\xc8\xb5\xc7\xb0\x71\xe1\x48\x0b\x04\x61\x58\x78\xdb\xed\x66\x03\x72\x0e\xdf\x4a\xd8\x6e\x43\x24\x88\xc8\x12\x6c\xbe\xd9\xd8\xfa\xfa\x2f\x2c\x7f\xbc\xdd\xc2\x36\xb4\x28\x62\x11\x77\xbf\xdd\x47\xcf\x53\xea\xf0\xd7\x3c\x95\x33\xe9\x12\xd4\x20\x72\x77\x2d\x67\xd7\x10\x17\x02\xb2\xbc\x84\xbb\x42\xeb\xa5\x8a\xc0\x4b\x6e\xd9\x4d\xe1\x5a\x2f\x1e\x89\x9d\x6f\xeb\x3a\x99\xa3\x93\xb2\x2c\x3c\x5a\xe2\x37\x39\x87\x4c\x40\xa4\x9b\xc0\xc8\x20\x35\x72\xeb\xb1\xaa\xb8\x62\x92\x6f\x4c\xf5\xe8\x94\x14\xd7\x76\x1b\x7a\x20\x1d\x92\x1d\x4e\xc1\x4c\x95\x45\x2c\xb3\x52\x41\x2a\x55\x69\xe8\x57\x95\xe6\x73\x8f\xa4\x89\x28\xe4\xad\x48\x60\x5e\xe4\x0b\xa6\x62\x22\xe6\x32\x93\x46\x6c\x7b\x76\x36\x81\x8b\x4b\x3d\x4d\x55\x69\x83\x80\x6e\x03\x1e\x11\xfe\xbb\xd1\x0d\x8f\xb5\x56\x3a\xb5\x0c\x65\x2b\x18\x30\x72\x0e\xd1\xcb\xbc\x7c\xb9\x4a\x53\xe2\xc4\x4c\xff\x3e\x86\xb2\x58\x09\xcb\x96\xad\xcd\x5e\x89\x77\x2b\x89\x8a\x13\xdb\x15\xfc\x47\x9f\x86\x2f\xe2\xf7\xe7\x22\xbb\x2a\xaf\xa9\xc7\x85\xf9\xeb\x98\x66\xc1\xfd\xb8\xa7\x7f\x94\x30\xee\x9d\x7e\xea\xbe\x43\x58\xc8\x8c\x61\xc9\xcc\x74\x61\x81\xef\x05\xfb\x5b\x9c\xae\x84\x22\xb0\xb7\xf4\xf3\xd8\x2a\xaf\x86\x64\x53\x05\x14\xec\xaa\x55\xbb\x5c\x6f\x36\xb0\x2c\x64\x56\xce\x61\xf4\xe0\xdd\xc8\x34\xb4\xa8\x90\x80\x77\xa3\x75\xa6\x5e\x0b\x64\xc7\x10\x24\xfe\xaa\xd1\x79\x97\x76\x18\x0f\x87\x47\x47\x5d\x6a\xfc\x59\x9c\xd7\x8c\xcf\xb3\x93\x5f\x20\x9f\xfe\x97\x98\x95\xd1\xb0\x5c\x2f\xc5\xde\xd6\x65\xb1\x9a\x95\xb0\x19\x0e\x92\xa9\x41\x1a\xe0\x91\x7a\x97\x46\xcf\x9e\x12\x56\xb3\x74\x85\x26\x16\x7f\xc2\x23\xfe\x83\x3e\xcc\xf3\x62\x26\x5e\xc4\xf4\x71\x9a\xe7\x29\x15\x5e\xe7\xf9\x4d\x65\x35\x7e\xce\xf3\x1b\x2a\x2e\x44\x59\xac\x59\x25\xbc\xaa\x7e\xd3\x37\x51\x14\xdc\x00\x80\x9c\x24\x74\x5b\xb2\x7c\xb7\xa9\x9f\xe7\x85\xe7\x28\x20\xff\x96\xab\x22\xd3\x5e\x00\x7e\xa9\xec\x2c\xf5\xf0\xa8\x83\x08\xda\x12\x6e\xf7\x51\x9a\xaa\x41\x21\x96\x85\x50\x22\x63\x35\x12\x53\x61\x3e\x87\xb9\x36\x96\x32\x63\xdf\xc9\x02\x82\xed\x36\x82\xbd\x13\xa1\x81\xdb\xa9\xe8\xd6\xb0\x10\x91\xbf\xb5\xdd\xe2\xdc\xc9\xec\xaa\x72\xb2\x50\x9d\x0e\x1d\x26\xda\x3b\xa8\xe7\x59\x29\xcb\x75\x7d\x54\xb6\x01\x6c\xb7\x3c\x9e\x45\xbc\x5c\xca\xec\x2a\x82\xa1\x61\xe8\xd3\x7c\xb1\xd0\x94\x31\x7d\x38\x25\x0e\x0e\x47\x47\x70\x56\xb9\x41\x6f\x97\x49\x5c\x0a\x28\x04\x32\x68\x0f\x4b\x16\x82\x12\x9d\x94\x73\xcd\x53\x88\x98\x60\x2f\x08\x97\x44\x54\xc1\xad\xcc\xd3\xb8\x44\x2a\x75\xab\x7d\xdd\xd1\x6f\x71\x2a\x11\xc1\xfd\xa2\xc3\x94\x3b\x78\xca\x68\x28\xc8\x0d\xdb\x2d\xfc\x3b\x99\x1e\xbb\x86\x70\xb3\x31\x20\xde\xc4\x57\xca\xd4\xfe\xbb\x58\xc3\x76\xab\x2b\xfe\xc6\x7a\xc7\x78\x16\xdb\xed\xbf\xb1\x8e\x3b\x1f\x8f\xb7\xdb\xc6\x7c\x38\x8a\x05\x9a\xfc\x61\x06\xcd\x42\xa4\x20\x86\x47\x5c\x26\xf3\xec\x39\x09\x24\x5a\x4e\x24\xa2\xb8\x15\xc5\x5a\x73\x7b\x37\x69\x65\xa9\x98\xb4\xc7\xf0\xf2\x97\x37\xf0\xf2\xed\xf9\x39\xcd\x0f\x4e\x43\xaa\x2d\x84\xcc\x60\x76\x1d\x17\xf1\xac\x14\x05\x35\x3a\xfd\xf9\xe4\x15\x79\xcb\xbf\x9d\xbc\xa2\xdf\x76\x72\xb0\x95\xa6\x0d\xb9\xd5\xa5\xb8\x12\xd6\x7b\xac\x4f\x79\x3e\x87\xe7\x2f\xdf\xbe\xa0\xde\x5e\x3f\x7f\x43\x9f\xff\xaf\xc8\x56\x0b\xd3\x20\x82\x37\xd7\xc2\x88\x6c\x3e\x87\x42\xc4\xc9\xe3\x3c\x4b\xd7\x15\x44\x66\xc3\x5b\x26\x4d\x12\x0d\xe7\xab\x6c\x06\x81\xe8\x54\x25\x9a\x29\xc6\x96\x8d\x82\x31\xeb\xb3\x8d\xd1\x81\xab\x22\xb3\x40\x69\x32\x55\xd0\x27\xb8\x0a\xa1\x9f\xd3\x11\xba\x9e\x5b\x9c\xad\xfb\xfa\x1b\x35\x37\xe3\x18\x44\x84\x25\x14\x02\x58\x67\xcc\x61\x1c\x6c\xb4\x0d\x61\x1e\xa7\x4a\x8c\x91\x8d\xaa\x2e\xbe\x25\x4a\xa3\x4d\x7d\x9e\xad\x16\xca\x68\x03\xd7\x06\x5a\xad\x64\x84\xa2\xa6\x7f\xaa\x79\xc4\x69\xc7\x29\x64\x97\x1a\xbd\xeb\x4a\xad\x7a\x38\x43\x8c\x7c\x3b\x95\xa5\x12\xae\xe1\x33\x3d\xac\x64\x56\xfe\xf5\x2f\xd4\xf1\x0b\xb1\x98\x8a\xe2\x40\xf0\x91\x89\xa8\xdd\x91\x62\x70\x70\xeb\xbb\x0f\x4c\x28\xf8\xf6\xd6\x95\x78\x39\x07\xf1\x0e\x83\x85\x27\xda\xc3\x20\x1a\xd9\x0a\x13\xf8\x0e\xbe\xff\x1e\x64\x5e\xc6\x56\xac\x59\xa7\xfb\x4e\x87\x15\x7f\x57\x86\x39\x2a\xdc\x6c\x20\x45\xce\xf8\x51\x16\xaa\xb4\xe3\x36\x63\x45\x5f\xd4\xaa\x2a\x44\x51\x7f\x70\x49\x44\x98\x53\x33\x60\xbb\x32\xdc\x7a\x6a\xad\x36\x46\xb7\x6d\x58\xc7\xd5\x62\x8a\x71\x8f\x83\xac\x36\x48\xbf\xc6\x85\x12\x4e\x73\x58\x62\x01\xce\xdf\x2c\x5f\x2c\xe2\xc7\x4a\x2c\x63\xbd\x3a\x83\x6a\x07\xf9\x00\x79\x60\x41\x28\x2b\x96\xc4\x3a\x8c\x40\x31\xd6\x63\x08\x9c\xe2\x50\x0b\xe0\x98\x87\x8d\xf1\xb3\x12\x65\x63\xdc\x72\x0e\x0a\x26\x13\x18\x8d\xb8\xa2\x23\xae\x4a\x94\x21\x64\x32\x65\x97\x2c\x13\xef\xcb\x63\xe3\xfe\xc0\xef\x21\x2d\x93\x20\x13\x68\x32\x69\x24\x54\xf4\x7a\x99\xca\x32\x50\x21\x8c\xc2\x91\xe9\xdd\x69\xb4\xa8\x5a\x74\xcf\x5c\xd5\x92\xf1\x5c\xe8\x1a\x93\x89\xee\xd8\xff\x8e\xff\xe0\xf8\x3e\x4c\x60\x11\x69\x10\x8d\xef\xb8\x22\x25\xb3\x95\x00\x1c\x89\xf7\x75\x3b\x6c\xfe\x72\x89\x30\x5f\x94\x11\x99\x83\x79\x30\x92\x19\xa9\x31\x97\x92\x3c\x43\xf0\xe0\xdd\x48\x53\x65\xcc\x24\x6b\x23\xa6\xe6\x84\x9f\x63\x5c\xc2\xa1\x75\x2f\xb8\xbb\x16\xe5\xb5\x28\x20\x4e\x53\x12\x4c\x9e\x6f\xd2\xc5\xe8\x57\x5d\x0b\x6c\x6d\x14\xb1\x72\x7b\x1e\xc3\xcf\xb1\x0a\x4c\x03\xef\x03\xfa\xa5\xb0\xf1\x50\x78\x68\x2a\x4e\x26\x86\xa9\x18\x9d\x93\x24\x81\x38\x49\x94\xd7\x7f\x99\x37\xfb\x7e\xe4\xf5\x71\x92\x24\xb6\xf3\x28\x8a\xbc\x6f\x9b\x61\xfb\xac\x2f\x1a\xf3\xfb\x48\xd1\xb4\x31\xcd\x34\x42\xaf\xc4\x22\xbf\x15\x50\xd0\x7f\x7c\xb4\x4c\x78\xda\x85\x98\x6e\xfe\x69\x70\x7b\xf8\xaf\x3a\x72\x67\x8a\xec\x5c\x63\x02\x19\x29\x60\x8b\x9a\x95\xb1\xcc\x7c\xdc\x59\xf5\x6a\xad\x5e\x21\xef\x21\xc7\xd0\x03\x6f\x0a\x51\x7e\x91\x3f\x9c\x9a\xc3\x8f\x93\x2a\x84\x56\x97\x16\x9f\x63\x1f\xfe\x0b\xeb\x4c\x26\xf0\x84\xc7\xfd\x9a\x44\x9c\xbf\xfb\x03\x8b\x77\x29\xb1\x90\xaa\xcd\xf3\x62\x11\x97\xb0\x52\x3a\x50\x79\xb1\x7e\xfd\x8f\xf3\x1d\xc3\xd7\x9d\x04\x63\x56\x28\x8c\x31\x4a\x95\x42\x26\x5a\xc4\x37\x22\x30\x71\x6d\x08\x4f\x42\x48\x45\x16\x74\x0e\x7a\x3c\xfe\x48\x52\xa1\x92\x8c\x48\xd0\x98\x58\x86\x83\xcc\xff\x34\x76\x13\x88\x97\x4b\x91\x25\x01\xfd\x19\xb2\xc2\x1a\xdb\x9a\xdb\x16\x1a\xb3\xd2\xfc\x7f\xb9\xcc\x4c\x33\xd4\x9b\x86\xe0\xb8\x10\x2f\x17\xcb\x54\x2c\xac\x8f\x80\xf1\xe9\xeb\x59\x9c\x65\xa2\x20\x7f\xb0\x98\xc7\x33\xb1\x4b\x0e\xb0\x62\xa0\x8a\x19\xc4\xd9\x7a\x0c\x01\xc6\x9b\x9e\x59\x50\x77\xb2\x9c\x5d\x03\xd9\x72\x55\xcc\xa2\x00\xbd\x7f\xf3\x71\x86\x2b\xd1\x99\x4c\x8f\xed\x08\x1e\xe1\x20\x9f\x54\x1f\x2f\x2e\xa7\xeb\x52\xb8\xdf\xc9\xec\xc0\xa4\x61\xe9\x02\x3d\x61\xc1\x2d\x4f\x06\xc1\xd6\x65\xbd\x9a\xdf\xea\x66\x89\x98\xc7\xab\x94\xcd\x90\x89\xa0\x27\x9e\x7e\x46\xd2\xe4\x25\x28\x24\xdd\x83\x37\x48\xa2\xdc\x65\xb0\x51\x08\xaa\x98\x35\x15\x34\x53\x5c\x9b\xef\x1a\xc9\x13\x5c\x17\x2b\xb4\x69\x6f\x25\xba\x03\x9f\x7c\xe0\x95\x08\xc6\x10\xb8\xcd\x6a\xe6\x58\xce\xe1\x1b\x15\x55\x92\x5e\xb1\x13\x33\x46\x26\xd3\xfd\x66\x87\xdc\x45\x78\x90\x8c\x42\x76\xf3\x02\x35\x6e\x8e\x0c\x54\x64\x64\xca\x58\x20\x72\x4c\x52\x25\x28\x9e\x7d\x9b\x25\xa2\x48\xd7\x28\x6b\x87\x7a\xa8\x7d\xdc\xc7\x86\x47\xba\xd9\xb4\xf5\xf9\xdb\xc1\x80\x9b\x7e\x69\xd3\x1b\x75\x3b\xad\xfb\x9e\x6e\x58\x59\xf3\x2b\x7d\x1a\x30\x54\xa3\xf6\x8c\x99\x6e\x25\x85\xcc\x9c\x35\x53\xc8\x8b\x44\x14\xcc\x26\x0d\x80\xc1\x18\x2e\x2e\x9d\x52\xd8\xb8\x93\xe6\x7d\xda\x40\xb7\xf3\xbd\x73\xed\xae\xf2\xc6\xb9\x94\x16\xec\x98\xd7\xbb\xcc\x18\x0d\x0e\xf7\x99\xf2\xcc\xee\x46\xb5\x0d\xd8\xc8\x80\xe8\x61\xc3\x58\xd9\x18\xd7\x8d\x54\xc0\x66\xf3\x49\x86\xb5\xdd\x56\x2a\x81\x09\x88\xcb\x8c\x4d\x59\xa0\xc0\xcd\x37\x67\x35\x61\x47\xa1\xd3\xf2\xd2\x26\xeb\xa2\x8f\xb1\xaa\x0d\x74\x27\x83\x1a\x0a\x18\x78\x8d\x31\xd4\x83\x8b\xf3\x78\x2a\xd2\x8a\xbd\x2d\xcb\x36\x87\x89\x83\xd0\x31\x54\x30\x72\x7a\x08\x1e\x24\xe3\x51\xd8\x14\xc1\x40\x8c\xc7\xae\x5e\x38\x54\x11\xe0\xb2\x43\x2f\xa1\x6d\x68\x03\x8e\xb8\x9a\x2a\xa0\x37\xc8\x4f\xa0\x07\x5a\x23\xb8\xaf\x3a\x61\x97\x4e\x88\x53\x74\xb6\xec\x92\xf3\x57\x4d\xb0\x53\x13\x70\x5f\xec\xfc\x88\x7b\xfb\x75\xbc\xfa\xb6\xc3\xaf\x73\x97\xda\x0e\xf5\xe8\x04\x60\xf0\xdf\xe1\xd2\x09\x98\xb8\x23\x0d\x6e\xbb\x1c\xb8\x1d\x95\x1b\x6e\x9b\x99\x83\x8f\xf7\xdb\x9c\x98\xfa\x3e\xbe\x9b\xb8\x9f\xef\x26\x3e\x95\xef\x86\x2b\x06\x96\x3b\xda\x7c\x37\xf3\xcd\x73\xdd\xb2\xa4\xa6\x9f\xb4\x15\xc6\xad\x5a\x8b\x10\xa6\x84\x90\xeb\x44\x5b\x2c\x5d\x8b\xad\x7a\xf1\x76\xb3\x0d\xe1\x61\xc7\x62\x2b\x81\x19\x0f\x07\x16\x2e\xad\x91\x7e\x02\xc0\x1a\x8e\x11\x8d\x97\xe2\xae\x03\x22\xee\xfa\xcd\x0a\x11\x97\x02\xe3\xcd\x4c\xdc\xf1\x2e\x8d\xde\xf7\x83\x3c\x63\x85\x64\xb7\xcf\xf2\x79\xd7\x5e\x86\xc9\x7a\x89\xb0\xe7\xb3\xf9\x81\x5b\x6f\xfe\x76\x9b\x99\x31\x2c\x25\x7e\x81\x55\x56\xca\x14\xde\x2a\x71\x9a\x67\x04\x6d\x16\xa7\xa9\x5d\x51\xdf\x3b\xd0\x60\xdc\xb9\xe4\x8e\xa4\xc0\xbd\x4b\x14\xf4\x87\xdd\xf5\x3c\x0e\x35\x1b\x90\xc7\xf0\x4c\x4b\xa5\xb3\x29\xe9\xe6\x1e\xec\x04\x48\x8c\x70\xdc\x39\xa5\x54\x25\x1c\x0e\x34\x2b\x27\x11\xef\x9d\x86\x90\x44\x3a\x60\xbb\x12\xe5\xa9\x2e\x0b\x7a\x4c\xcf\xd8\xc8\x9d\x85\x04\xdf\x4c\x50\x1e\x1c\xd9\x4b\xa2\x64\x0a\x93\xaa\xaf\x68\x59\xc8\x45\x5c\xac\x9b\x22\x95\x30\xa7\xf5\xdc\x5b\xe3\x66\xda\x24\x50\x56\xc9\x1a\xed\x7b\x6c\xac\x3b\xdb\xfa\xe6\xd6\x21\xcf\x73\xcf\x6e\x02\x06\x67\xd6\x72\x3d\x14\x3c\x63\xd2\x41\x77\xa7\x91\x14\xea\x42\x83\xbc\x64\xad\x81\xb1\xde\xeb\xeb\xb8\x48\x60\xbb\x97\x00\x54\x0f\xf7\xdf\xfc\x2d\x76\xc6\x51\xef\x58\x16\xf9\xaa\x14\xaa\x2e\x05\x65\x0e\x31\x28\x6c\x6e\xbc\x20\x26\xc6\xd1\x11\x3c\xa7\x3d\x34\x5b\xd9\xe4\x5e\x28\xdb\xe2\x46\xac\x59\x31\xe6\xb8\xc9\x95\x25\x94\x7a\x62\x5c\xbc\x3e\xf8\x72\x3a\x1b\xe1\x1f\x61\x01\xe6\xb3\x79\x83\x6d\x52\x8d\x2a\xbb\x39\x32\xcb\xeb\xb5\x92\xb3\x38\xd5\xf3\x48\xab\x76\xb6\x39\xfa\x8c\x14\xd6\xb1\xff\x83\x35\x40\xad\xe6\x73\xf9\x3e\x32\xfb\x11\x7b\x3a\xc2\x3d\x09\xfa\xe9\xed\x32\x50\x49\xc4\x55\x8c\xe7\x6a\x24\x80\xf3\x61\xcc\x9f\xb8\x67\x1a\x02\xf5\xc2\x5f\xd8\xac\x8c\x1a\xbb\x0e\x7d\x50\x7a\x25\x54\x9e\xde\x8a\x02\xfc\xbf\x26\xf0\x22\x4f\x56\x69\x6e\x0a\x36\xec\xbc\x88\x72\xdf\x4c\x58\x10\x4a\x30\x49\x0b\x53\xc2\x4c\x61\x39\xa4\x31\xf5\x61\xad\x57\xa4\x32\xfb\x0f\x5a\x4d\x97\xa8\x4a\xc9\x55\x88\xe7\x94\xf0\x85\x6a\x15\xf3\x54\x67\x79\x36\x5b\x15\x85\xc8\xca\x74\x0d\x77\xb2\xbc\x76\xf9\x32\xcf\x5c\x66\x24\xc9\x3c\x60\x20\x81\xc5\xdf\x2b\x36\xb6\xb6\x37\x81\x27\x60\x00\xed\x57\x42\xcc\x0a\x9e\xfa\xb9\x2f\x63\xee\xd3\x44\xba\x2f\x8a\x3e\xe8\xa7\xaf\x71\x78\x59\x93\xbf\x05\x99\x4c\xc7\x9d\xfb\xb2\x54\x4d\x45\x51\x34\xf6\x1d\x16\x27\x25\x42\x27\x88\xe2\xb4\xe8\xac\x57\x28\xc4\x2c\x2f\xec\x6e\x73\xb2\xcf\xf4\x8d\x19\x50\x30\x2b\xdf\x03\xa7\x1a\x63\x0a\x1d\xfe\xd7\xe4\x3f\xd5\xb6\x84\xc7\x41\x1a\xab\x52\x37\x3b\x7b\x86\xae\xe0\x5f\xff\x42\xae\x1d\xbb\x77\xd6\xbb\xc3\x05\x65\x0d\x61\x8c\x5b\x63\x4f\x98\x18\x0e\x41\x5c\x40\xec\x1d\xaa\xe8\xa5\xb8\x0b\x46\xb8\x7f\xb7\x30\xfd\xb3\x4b\x3b\x15\x20\x16\xcb\x72\x3d\x72\xfd\x3b\x39\xe7\xb5\xcb\xd9\xb5\x98\xdd\xf8\x8a\xfb\x53\xec\x8c\xbb\xf0\x0c\x41\xcc\xbe\xf5\xdf\xa8\xeb\x86\x09\xe5\x85\xd0\x16\x1c\x3f\xeb\xc6\xbd\xc1\x0d\xc3\xb4\xde\xa8\xcd\xf2\xb4\x63\x3b\x80\x67\x6f\xcc\x5b\x27\x5e\xd5\x38\x5b\xb7\xd7\xe3\xfd\x01\xca\x8e\xf0\xf6\x08\xf6\xe4\x09\x57\x58\xca\x39\x12\x3a\x84\xfc\x06\xdb\x6b\x24\x2e\x08\xde\xe5\xdf\xb0\xb0\xaa\x69\x87\x60\xf7\x0c\xf0\x2f\xee\xbc\xda\x31\xb0\xe8\xdb\x6a\xf8\x17\x4d\xa7\xb3\xad\x00\x3e\x5b\x21\xfb\x22\xb4\xc3\x99\x37\xcb\x11\xb2\x4c\x38\xcd\x85\xe4\x72\x9e\xaf\xb2\xc4\xe3\xdc\x86\x2f\x81\xc0\x6f\xc4\xba\x36\xee\x0e\x26\x31\xd6\xfa\xd2\xa0\xfc\x4d\x7e\xb3\x0f\xcf\xe7\x45\x61\x9a\x99\xfc\x4d\x07\x27\xf4\xda\x43\x93\x2e\x8f\xcc\x7d\x8c\xae\x20\x59\x16\xd4\x10\xa1\xc6\x8f\xb3\x36\xb8\xd3\x7e\x8c\xe6\xa8\x2f\x67\xf0\x79\x01\x01\xa6\xfa\xbe\x91\x0b\xa1\xd9\x20\x3a\xa5\xb0\x04\x0b\x60\x34\x1a\x37\x3e\xeb\xfc\x2f\xf3\x99\xa1\xcd\x56\x05\x95\x1c\x4f\xa0\x94\x0b\x11\xbd\xcc\xef\x82\x71\x47\xb7\x5d\x5d\x72\x4d\x39\x87\xdf\x6b\x33\x81\x27\x2a\x5a\x5b\x6d\xb7\xa3\xcb\xbf\xd5\x88\xdf\xc6\x95\x5d\x00\x2a\x3e\x64\x1c\xc5\xbb\x36\x1c\x71\xd9\x6f\x24\xb3\xd2\xcb\x85\x6e\x63\x6d\x26\x09\x26\xad\xbf\x0f\xc6\x3e\x74\xb3\x34\xd9\xa3\x7d\xad\x61\x45\xc9\x6d\x7f\xf2\x7a\x53\xd6\x9b\xbc\x55\xab\x7b\x92\xd7\x03\xb0\x8f\xbc\x5c\xf9\xcb\x26\xaf\x9c\x22\x37\x56\x07\x75\x50\xdb\x68\xc9\x7e\xaa\x0b\x98\xe9\xe5\x34\x62\x81\xcf\xca\x1c\xad\x4c\x29\x16\xcb\x14\xd3\x26\x47\x9c\x48\x1f\xe1\x2a\x8d\xa9\x7b\x9a\xa7\x0a\xc3\x26\xed\x69\x70\x21\x9b\xa8\xdb\xd8\x29\x56\xef\xd2\x10\xe2\xe2\x8a\xcc\x80\x9c\x46\xd4\x2b\xf7\xb9\x88\x8b\x9b\x7f\x16\xb2\x2c\x51\x6b\x96\xef\xc7\xc3\x41\x21\xd4\x2a\x2d\x1d\x35\x22\xde\x8b\x19\x7e\x0b\xc1\x43\x09\xd3\xfb\x45\xa1\x71\x0a\x61\xa4\x31\x1f\x61\x8c\xeb\x44\xd9\xd1\x59\x22\x16\xcb\xbc\x14\x19\xeb\x32\x15\x56\xe8\x8c\x87\x03\x4f\x0f\x6d\x86\x83\xc1\x2e\x25\x8d\xc1\xb4\xf9\xa8\x31\x8c\xce\xab\x3a\x49\x60\xd6\x4f\x74\x2f\x2f\xe2\x6c\xcd\x87\x70\x14\x2c\x56\x69\x29\x97\xa9\xe7\x68\x99\x6c\xa2\xfe\x9e\x16\x82\xec\xf0\xb6\xce\x31\x61\xe9\xe2\xb2\xe6\x72\xd5\xb6\x9c\x07\xae\x77\x85\x2d\xac\x8d\x32\x03\x1f\x0e\x3c\x3b\xe6\x54\xfc\x01\xfa\x9c\x1a\xaa\x24\xad\x65\x89\xb1\x10\x33\x41\x27\x1c\x1e\x24\x44\x8b\x10\xc4\xfb\x99\x10\x89\xc9\xe4\x5c\xc4\xef\xe5\x62\xb5\x80\x07\x98\x7f\xb5\x90\xe5\xc8\xf1\x12\x08\xdb\xb0\x0f\x0e\xc6\x56\x0e\x30\xf4\x42\xf6\x04\xe3\x9f\xe8\x22\x64\x4d\xa6\xd6\x05\x12\x69\x38\x40\xcf\x83\x4e\x08\x19\x67\xa8\x72\x3f\x6c\xdf\x44\xa3\x76\xe7\x74\x60\xb9\xe6\x00\x4f\x74\x80\xfc\x34\xf8\x82\xbc\x50\x3b\x8a\x3a\x6a\x7f\x9a\xf3\x59\xc7\xa8\x8f\x23\x39\x18\xdc\xdf\x8d\x1c\x0c\x06\xfb\x3d\xc8\x81\xae\x45\xec\xe2\x30\xc0\x60\xd0\xe1\x4e\xe2\x77\x1c\xc0\x60\xd0\xa6\xd2\xc9\x99\xe4\x1a\x66\x98\xc4\x9f\x5e\x3d\x2c\xa1\xba\x6a\x8c\x32\x3a\x68\x73\x34\x0d\xbd\x7a\x3b\x95\x83\x2e\xf3\xdb\xe6\xdd\xa0\xfc\x5c\xc7\xea\x24\x49\xf4\xd7\xea\xf4\x45\xc3\x30\x23\xc2\x17\x4f\x2e\xeb\xe6\xf9\x73\x79\x3f\x1e\x56\x93\xfa\x9e\x53\xcd\x20\xb6\x0f\xb8\xcd\xdf\xa8\x06\xcc\x07\x08\x0e\x1e\xf0\xe7\xf2\x47\x3c\xac\x7a\x0e\x78\xbf\xdb\xbc\xdf\x6f\x1e\xb0\x0b\xd2\xdb\x69\xf6\x03\x15\xca\x2d\xc4\xa5\x06\x9d\x5f\x79\x55\xe4\xab\xa5\x5e\x31\xa1\x68\x21\xa4\xa4\x79\x6d\x39\x75\x31\xae\x50\xa8\x32\x2e\x69\x4f\x09\x96\xb8\xa8\x85\x15\xa9\x43\xda\xcd\xa6\x76\x06\xa6\x97\x6b\x6c\x22\x93\xc6\xc1\x22\xfc\x97\x3c\x1a\xfc\xc1\xb6\xc1\x94\x37\xcc\x43\x45\x54\x64\x06\xea\x4d\xc1\xc5\xe5\x23\xb7\x5f\x1b\xc0\x56\x66\xc4\x37\x22\x8a\x6d\x88\xe9\xa5\x1e\xaf\xe1\xe7\x0b\x6a\x7c\x79\xd1\xa1\x61\xfd\xc8\x8d\xd9\xd0\xe7\x28\xc7\xf6\xee\x8a\xd9\xaa\x11\xdd\x2b\x76\xe3\x6e\x3d\x5d\xdd\xd2\xbb\x28\x8a\x96\xde\x90\x86\xec\x02\x41\x93\x84\xce\x3a\x80\x2c\x85\x93\x2a\xc8\x64\xf7\x3b\x92\x78\xda\x43\x2c\x22\x1c\x01\xea\x62\xfa\xef\xc3\x87\xba\x90\x06\x84\xa5\x7c\x2e\xca\x6b\x89\xff\x1a\x2c\x26\x54\xbf\xf1\x79\x5a\x88\xf8\xc6\x2b\xdd\x0e\x9b\xbf\xe4\xbc\x82\xd3\x4e\x0b\xd3\xc9\x43\x77\xb0\x1b\x44\xf5\xd8\x25\xfd\xb1\xfe\x4f\x05\x19\xff\xe1\x51\x5b\xfd\xa0\xff\x0e\x0d\xd4\x71\x0b\x42\xfc\x29\xb2\x3c\x67\x1b\xd7\xbf\x18\x6b\x52\xb5\x67\xca\x73\xc5\x0e\xe2\xf7\x0d\x28\x38\x26\x60\x27\x19\x83\x0a\x83\x04\x0d\xd6\xab\xd4\x8c\x26\x1c\x94\x7c\x79\xaa\x8f\xa4\x46\x73\xd4\x3b\x4e\xe4\x22\x17\xec\xba\xe8\x50\xc1\xab\xba\x23\x60\xf1\x49\xda\x15\xbe\xb4\x86\x30\xe6\xc3\xef\x26\x9d\xd2\x8d\x61\x0c\xf2\x7a\xee\x39\x6c\x41\xe7\xfe\xc0\xd0\xe5\xa3\x24\xd1\xdb\x90\x33\x47\x0c\xdc\x18\x74\xd0\x67\x8a\x07\x8d\x80\x71\xaf\x67\xa8\x1b\xf9\x73\x3d\x68\x9d\xe4\x96\xc9\xdd\x33\xb1\x83\x41\xcb\x74\xa2\xaf\xb4\x6b\x02\x07\xcd\xe0\xb3\x75\xca\x70\x6f\xf3\x63\xa6\x6a\x50\x9f\x23\xd7\x51\x73\x03\x4b\x6f\x26\xec\x16\x12\x86\x95\x3f\x89\x12\xd7\xc6\x0a\x29\x6e\x45\x63\xd5\x1e\xca\xeb\xb8\x84\x85\x30\x9b\x3d\xef\x56\xa2\x58\xc3\x8c\xe2\x63\x19\x1f\x10\x69\xfe\x24\xca\xf6\x10\x13\xf7\x02\x6d\x1a\xff\x0e\x08\xa7\x79\x96\xf0\x19\x98\x1d\x9e\x36\x1f\x5d\xec\x42\x43\x57\x71\xb7\x05\x90\x5c\xaa\x85\x19\x5f\xd3\xbd\x16\x0e\x33\xaa\x69\xa4\xcb\xba\x50\x60\xaf\xc6\x08\x3a\xbb\x28\x18\x9b\x55\x6e\xca\x40\x4d\xa3\x1f\x8b\x7c\x11\x74\xe0\xe9\x30\x74\x6d\xc2\x06\x39\xe2\xda\x9d\x5d\x80\xa4\xc2\xa5\x93\xac\x89\x8a\xef\x2d\xf9\x1c\x4f\x7a\xf8\xe9\x9a\x5a\x3b\xca\x6c\xaf\x01\x7f\x94\x47\xde\xa6\x2c\x6f\x56\x72\xd4\x35\xec\xd2\x22\x1c\x80\x71\x44\x6d\x08\xe3\x68\x6f\x77\xe4\xea\x5d\x7a\xc2\x52\x46\xf3\xb2\x97\x02\x0f\x15\xea\x82\x2c\x09\xe1\x61\xae\x67\xf0\x9f\xd7\xa2\x10\x01\x03\xb2\xb4\x51\xd3\xe8\x17\xdc\x5a\x7b\xba\xc6\x6c\xca\xe8\x57\x9d\x5a\x80\xcb\x73\xd1\x33\xa1\x66\x3c\xfb\xb4\x02\x11\x7c\x37\xae\x09\xbc\xf2\x35\x36\xa5\x31\xb8\x07\xc8\xab\xc1\xaa\x77\x29\x4c\xe0\xc7\xea\x1b\xcd\x17\x9e\x53\xfe\x3f\xc8\x7c\xac\x39\x07\x44\x53\x91\x25\x2e\xb1\xcb\xb8\x28\x35\xb1\x47\x3f\x89\x72\xd4\x4f\xfc\x49\xf4\x13\x31\x17\x05\xa0\x88\x52\xea\x10\xc6\x7f\x05\x64\x7a\x7b\x4c\xaf\x10\x74\xb0\x33\x4b\x94\x03\x79\x90\xe1\xad\x2d\x1c\x4a\x22\x7e\x13\x74\xc9\x83\x8c\x90\x45\x75\x88\xc4\x2a\xf2\x3b\x4e\xf5\x3f\x9e\x00\x9a\x26\x51\xb4\x2c\xb0\xe1\xe9\x54\xb3\xc0\x36\x8e\xfe\x81\x4a\x85\x15\x82\xae\x6c\x87\x48\xf3\xd4\x67\x94\xd8\x6f\x74\x9a\xe6\x4a\x20\x16\xe8\x3b\x61\xc1\x4b\x84\xa8\xc7\xbe\x7f\xa8\xdd\xc9\x36\x26\xe3\xa9\x1b\xd4\x6b\x1d\x24\x34\x55\x0a\x95\x07\x99\xb8\x0b\xf6\x9f\xb5\x1d\x5b\xfa\xd2\xa8\xe8\xa4\xc7\xde\x3e\xa3\x93\x24\x29\xba\xaa\x31\x70\xcb\xf9\x5d\xb2\xe9\x98\x70\x5b\xb6\x75\xcc\x09\xaf\x48\xea\xd3\x35\xf7\x34\x1e\x90\xca\x1b\x01\x3f\xe1\xe1\xb9\xe9\xaa\xe4\xee\x14\x3c\x2f\x8a\x97\x79\xf9\x23\x2e\x23\xa0\xea\xc0\x14\x55\xa1\xd3\x02\x32\x71\x80\xbd\x21\xd4\x3e\xda\xe2\xf4\xb4\x27\x6c\x4b\xf6\x12\xdf\x11\x6b\xb6\x87\x8c\x4d\x37\x9b\x23\xd7\x57\x2b\xc5\xbd\xc4\x76\xd2\x0e\xe3\x21\x9d\x39\xd9\x70\x7a\x49\xc7\xe0\xac\x11\x0a\xe1\x17\x93\x69\x71\x0c\x23\xa2\xea\x28\x84\xbf\xcb\x2c\x39\x76\xa7\xca\x65\x8e\xfd\xe8\x99\x8c\x47\x74\x3f\x4e\xf3\x55\x56\xcd\x3e\xfa\x18\x65\x5e\xc6\x29\x64\x2b\x3c\xab\x85\x79\x10\x0e\x47\xa9\x8a\xa5\x3e\xce\x1d\xa1\x5e\x3f\x9a\x3d\x34\xa6\x32\x2b\x3f\xd2\xab\x18\xcd\x08\x9d\x47\xe3\x91\x67\xb0\xbf\xfa\x0e\x5f\xae\xef\xf0\xe7\x3a\x02\xc4\xbe\x1f\xe5\x0a\x54\xf6\xfb\xbb\xff\x0c\xfb\x5d\x37\x88\x0f\x49\xf8\x3e\x99\x29\x3b\x97\x9e\x25\x6b\xdd\x58\xfb\x54\xca\xe7\x5c\xee\x32\x4d\xb4\x37\x15\x42\x3e\x9f\xe3\x61\x63\x99\x1d\xa6\x8e\x3a\xf4\x2e\x2f\x3b\x76\xa1\xe6\x9a\xa8\x7b\x6b\xb2\xaf\xf1\xd1\xff\xba\xf8\x88\xc7\x40\xac\x0b\xdf\xe3\xb6\xd5\x87\x0f\x7a\x93\xf5\xa0\x8d\xdd\x2a\xcc\xea\xbf\x11\xab\xf3\x1a\xea\xed\x53\x77\xab\x96\xb1\x63\x89\xfa\xa1\x4a\x9b\xc2\xfa\xbf\x50\x69\xa0\x3f\x9a\x16\x7f\xae\x66\x47\x41\xfd\x44\x8a\x9d\xe2\xbc\x80\xcf\xd0\x77\x29\x86\xf1\xf8\x0b\x34\x01\x7f\x40\x90\x85\x8b\x83\x87\x87\x89\xc7\xbd\xe3\xc4\x2f\x32\x86\xeb\x1a\xa1\xbf\xaa\xbf\xa7\x62\x08\xfb\x11\x6d\xda\xd9\x93\x34\x75\xcc\x2c\xa6\x77\x7f\x0e\x0b\x7b\x92\xa6\x1d\x06\xf6\xab\x61\xfd\x6a\x58\xff\xd3\x0c\xeb\x0f\xf0\xa4\xdb\xd0\xfd\xb9\x66\xeb\x24\x4d\xbf\x5a\xad\xaf\x56\xeb\x7f\xaa\xd5\xe2\x5c\x18\xba\x38\xdf\x9c\x98\xfb\xc4\x26\x4b\x77\xd1\x6e\xb5\x5a\x0f\xbd\xdc\x6f\xa5\xca\x3f\x18\xe3\x24\x6e\x12\xb0\x46\xbe\x97\x59\x71\xfc\x13\x52\x09\xdb\xf3\xf6\xda\xd0\xfa\x43\xd2\x08\x77\x64\x36\x56\xf8\xec\x34\x78\x8d\x74\xae\x7e\x27\x28\x6a\xe7\x4a\x58\x28\x68\xd5\xc3\x3b\x33\xa1\x19\xc7\x1c\x99\xf8\x18\x53\xfd\x19\xad\xf2\x47\x9e\xcf\x18\xac\x5a\xbc\x25\x3d\x70\xc7\x5b\x5a\x4d\x39\xa7\xac\x23\xc1\x7c\x40\xb9\x8a\xa8\x09\x7a\x9c\x31\x1a\xf8\x79\x57\x5e\x06\x01\x9e\x02\x43\x86\xac\xe0\x59\x15\x64\x8b\x42\x58\x4d\xa3\x13\xa5\xe4\x55\x16\x54\x60\x10\x30\xe5\x10\x63\x2f\x40\xac\x6d\xa5\xd0\x36\x6d\x95\xc4\xc3\x12\x0c\xef\x77\xb4\x00\xff\xe9\x3b\xa6\xae\x74\xc2\xd0\x49\xe1\x33\x67\x12\xba\x0f\x25\x7c\x8e\x9e\xeb\x5d\xfa\x29\x84\x15\x77\x45\xaf\x45\x59\xf5\xb4\xc7\x97\xfe\x08\x11\xbb\xbf\x5b\xb9\xf2\xdc\xca\x55\x9b\x5b\xe9\xbb\x80\xab\xce\x6c\x94\x7b\x1e\x85\xd0\x9c\xd3\x91\xa4\xa2\x2b\xf4\x4c\x52\x69\x39\xf9\xf0\x2a\xbf\x53\x27\xf3\xb9\x98\x95\xa2\x3a\xf9\xf0\x4c\xa4\xa2\xf4\xaf\x8c\xfc\xc4\xd6\x57\xf7\xd0\x6e\x7d\xff\x28\x33\xfb\x1f\x14\x64\x7d\xac\x3a\x4f\x5a\xd4\xb9\x9e\x02\x47\x9d\x27\xd3\x48\x97\x99\xc0\x75\x97\x4a\x3f\x5c\x98\x12\x4f\x98\x92\xfd\xc2\x94\x7c\x0e\x61\xd2\xa3\x1b\xe9\x0d\xaf\x4f\x28\x31\xce\xd5\x03\xaf\x30\x46\x11\xd9\x8c\xae\x5a\x32\xa7\xd2\x7f\x12\x25\xcf\xa4\xb3\x14\x63\x6e\xba\xf8\x35\xc6\x63\xf6\xd5\xc1\x73\xde\xca\x2f\x0c\x20\x7b\x37\x94\x77\x57\x97\x39\xfc\xaf\x6b\xd3\x35\xe5\x79\x41\x1c\x21\xe7\xb5\x9a\x52\xd1\xc5\xe9\xf8\xa6\x51\xc7\xe6\xfe\xb7\xbb\x66\x90\xc4\xd5\x1f\x45\xbb\xd8\x56\x40\x76\x06\x45\x9d\x1d\x71\x30\x00\xc1\xa3\x3a\x59\x7a\xe4\x02\x18\x86\x47\x67\xf0\x4c\xf1\x53\x26\x46\x5e\x36\x1b\xa7\xb0\x21\x32\x48\x35\xf7\x72\xe6\x9a\xec\x98\x3f\x2b\xae\x2a\x04\x5e\x59\x9f\x67\xcf\xe2\x7c\x64\x10\x35\x35\x19\x64\x7d\x04\x4d\x8a\x3c\x8b\xf3\x2a\x27\x01\xab\xbf\x12\x73\x14\x11\xd8\x6e\x83\xea\xc6\xfe\xb1\xd1\xc8\x58\x74\x9e\xc7\xc9\xa1\x7c\xa4\x0e\x63\x24\x85\x8c\x44\xf7\x3d\x9c\xbd\xa4\xd5\x40\x0c\xbe\xf0\x6a\x89\x12\x16\xb9\x2a\xcd\xf6\x42\xf7\xe4\xd0\xae\x00\xbb\x6c\x74\x99\x3c\x9f\x0a\xd0\xef\xe0\xd8\xb1\x32\x02\x11\x76\xf9\xa6\xc2\x80\x9f\xe8\x6a\xe7\x61\x3c\x67\xa0\x6e\xe4\x72\x29\x92\x43\xd9\xd7\xa1\xde\xe1\xfc\xeb\xac\x3a\xee\xe7\x5f\x3c\xb8\xc7\x53\x48\x2e\xdf\x76\x7b\x79\x5f\x96\xbe\x11\xeb\xea\x9c\x54\x0b\x5c\x45\x0b\x02\x9b\xad\xbd\xba\xb7\x73\x04\xec\x9c\xb1\x23\xdc\x7e\xfc\xaa\x27\x0c\xce\xf3\xed\x21\xf3\xd6\x87\xdf\x07\xd9\x11\x4c\x39\xef\xac\xcd\x90\x75\xce\xcd\x66\xe3\x0b\x3d\x7c\xf8\xe0\x0b\xbc\xbd\xcd\xce\xe9\x80\xad\x31\xdd\x61\x6e\x0b\x2b\xcf\xf8\x46\xd0\x02\x0e\x13\xdb\xc8\x76\x3d\xb6\xc4\xc9\xb9\xb8\x11\xeb\xc6\x49\x20\x33\x73\xf4\x11\x26\x7c\x92\x65\xb3\xdd\x54\x70\x9c\x79\xb0\x8e\xb7\x91\x98\x1b\xb1\x1e\xef\x48\xf2\xb6\xd9\xfc\xbb\x18\xe2\x00\x46\xf3\x22\x2f\x1e\x9b\x53\x66\x5c\xa6\x9a\xb2\x64\x1c\xda\x14\x66\x3f\x0d\x89\x77\x03\x59\xcb\x5d\xc7\xb6\x39\xd7\xa8\x27\xb1\xc9\x19\xa5\x75\xd3\x89\x2a\x4f\x96\x47\x5c\xe4\xaa\x94\x91\x59\x45\xe8\xe3\x31\x55\xc9\x5e\xd5\x68\x2a\xee\xde\x83\x5d\x9d\xc7\x53\x9f\x8f\x99\x58\x17\x8c\xa0\xe6\xa5\x4b\x98\xf4\x86\xec\xe0\xd4\x46\x7f\xf2\x3d\x8c\xa1\x6a\xf3\x42\x92\xa7\x6b\xc7\x0f\x41\x29\xdb\x6d\x40\x4e\xaf\x65\x9a\xec\xb6\x1f\xe6\x64\x2f\xfb\x27\x9e\x3e\xb7\x94\x27\x47\x44\xdf\x69\xb5\x23\x46\xd0\x59\x88\xe7\xfc\x26\x43\xad\xdf\x26\xb3\xe2\xf4\x1f\xaa\xe8\xa5\xfa\x23\x1c\x95\xfd\xc9\x23\x9d\x03\xe3\x00\xe6\xe2\xf2\xd1\xde\xaa\xad\x96\xc1\x3e\x3b\xe6\xdd\xbd\xbc\x4f\x14\xa9\x1f\xd8\x7a\x1c\x55\xeb\xbf\x49\x13\x9c\x03\x93\x42\x53\x1b\x75\x68\x74\xd7\xc5\x65\x0d\x4c\xfb\x88\xf5\xed\xcb\x91\xeb\xe8\xe0\x6f\x83\xff\x78\xcb\x24\xc4\x60\x8b\x2f\x3d\xaa\x71\xb8\x9c\xb7\xb2\x37\x0a\xde\x59\x56\xe3\x6a\xc3\xc2\xe6\xd1\x4f\x62\xd2\xb6\x8b\x98\x43\x7b\x25\x61\x9a\x63\xa2\xb4\xbd\x76\xb6\x2e\x04\x22\x41\x06\x9f\xae\x21\x47\x57\x9a\xef\x8e\xd2\x4f\x01\xb1\x36\x47\x0f\x45\x3b\x4e\x09\xde\x56\x3b\x8d\xcb\xd9\xb5\xf6\xa1\x7a\xa4\x59\xe0\x15\x5c\x37\x42\x2c\xa9\xef\xb3\x97\xfa\x1e\x35\xe3\x92\xf1\xfb\x15\xcb\x34\x9e\x89\xeb\x3c\x4d\xf0\x99\x8b\x2c\x01\x7c\x3b\x56\x94\x7a\x5a\x0e\xb9\x8a\xa0\x52\xaa\x4d\x09\xb1\x97\x7d\x85\x86\x6a\x66\xc5\x8e\x47\x79\xc1\x57\x12\xd8\x4e\x3a\x5d\xa6\x1e\x8c\x5d\x31\x37\xad\x01\xba\x76\xe8\x07\x8e\xdc\x89\x94\xce\x9a\xee\xf1\x42\x66\x81\x53\xb1\xdf\x8d\x02\x97\xfa\x58\xb6\x5e\x09\x64\x48\x08\x84\xa0\x8f\x8f\xf1\xb3\x67\xa1\x92\x08\xff\x7c\x8a\x5f\x91\x56\x2d\xb4\x09\x41\xb7\x1d\x0e\x5a\xa2\xc8\x41\xdd\xc8\xe8\x6c\xfe\x7d\x74\xb3\x3e\xc1\x9e\x8a\x28\x8e\xbc\x7c\x56\x05\xaa\x1c\x32\x58\xbc\xef\x2b\x15\xfa\xe2\xb7\x18\x94\xcc\xae\x52\x61\x22\x82\xf5\x81\x3c\x66\x49\xf7\x85\xb1\xd9\x67\xc9\x07\xb0\xbb\xdb\x7d\xf6\x44\xbc\xdd\xea\x69\x84\xc2\xc8\x0c\xa5\x87\x8f\x10\xc7\x7f\xee\x3e\xb3\x33\x43\x5f\xe4\x5e\x33\x1e\xdd\x43\x85\x8d\xa2\x39\xfe\xba\xbf\xfc\x45\xef\x2f\xff\xb9\xdb\xcb\x0e\x15\x9e\x17\x24\xdb\x8c\x95\xe7\x61\x1c\x1d\x01\xf1\x10\x31\x19\xdd\xc9\x1a\xc3\x6c\xa5\xca\x7c\xc1\x6e\x2c\xdf\x7d\xc9\x37\xe2\x92\x01\x76\x8f\x52\xb4\xaf\x92\x1b\xdf\x97\xbc\xe3\xd8\x3d\x9b\xb3\x88\xd7\xf4\x4c\x25\x66\xec\x47\x57\x11\xbc\x7e\x7e\xfe\xfc\xf4\x0d\x3a\x90\xf0\xe3\x2f\xaf\xe0\xed\xaf\xcf\x4e\xde\x3c\x8f\xe0\xad\x12\x1a\xaf\x57\x62\x99\xca\x59\x8c\x7e\x02\x32\xbe\x7e\x5f\x18\xf3\xa5\xa9\xf8\x00\xcd\x4c\xd0\x02\x8d\x84\x51\xba\xa4\x63\xa2\x28\xd2\xa6\x9d\x2e\x59\x78\x65\x04\xaf\x72\x3c\xd9\xa0\x25\x11\x35\x0e\xf8\x40\x2d\xc1\x1b\x85\x9a\x4a\x46\x4f\x68\x3b\xe4\x21\xde\xa4\x6b\xf5\x70\x22\xb5\x3d\x90\xa4\xce\x3d\xa6\x0a\x87\x57\xe6\x15\x39\xec\x81\x27\x82\xc9\x3b\x5a\x59\x2a\x54\xf5\xc8\xad\x54\xae\x86\x3c\x94\x7e\x3c\xa8\x4f\x43\x46\xa3\xc8\xd8\x48\x3e\x8d\x67\x37\x78\xa3\x46\x96\x04\xe3\xb1\x21\x30\x77\xd8\x42\xe7\xde\x88\xeb\xee\xf0\xac\x88\xb9\x46\xc3\xd3\xf2\x2d\x43\xb9\xb8\xec\x1e\x49\x87\x0d\xd2\xd0\x76\x59\x21\xfa\xea\x44\xb8\xbb\x4d\x51\x0b\x4d\x9a\x58\x1b\x6a\x74\x69\xa3\xba\x37\xe6\x84\xd7\xbb\x4c\x0c\x92\xaa\xcd\xb2\x38\x7d\x5a\x45\xc8\xe0\xd9\x5c\x65\x49\xf0\x84\xcd\x98\x96\x84\xe7\xef\xc5\xac\x45\xb3\xbc\xfe\xc7\x79\x75\x2d\xca\x01\x3c\x88\xe0\x3a\x79\x0f\xe7\xf7\x55\xb5\x55\xf2\x19\xa6\x8c\x07\x6c\x36\x60\x5a\xe7\x89\x35\x04\x62\x3b\xe2\x9d\xac\xda\x8c\xf1\xc1\x78\x2d\x01\x3c\x47\xaa\x7e\xfd\x3c\x86\x05\x58\xa3\x9a\x77\x15\x3a\xb2\xee\x08\xb6\x51\xce\xf4\xde\xb3\xbe\xdf\xf2\x00\xaa\x56\x0e\x45\xdd\x61\x1d\x1b\x89\xd9\x45\xc5\x0f\x1f\xaa\x4b\xd3\xcd\x1d\x22\x1f\x3e\xe0\x53\x6d\x9c\x6d\x88\x50\xcd\x1c\x78\xe4\x4b\xa6\x6d\x44\x35\xf7\xaf\x33\x4a\x76\xaf\xc9\xdb\x7d\x44\xc6\xa2\xfd\xc6\x5d\x84\x33\x2f\xdb\xfa\x57\x71\x1b\x57\xdf\xbb\x5f\xbc\x76\x7f\xf4\x01\x54\xb3\x9b\x98\x4d\x2f\x1f\xc1\xd1\x0a\x33\x0e\x83\x6e\x99\x1a\x43\x4d\x01\xf1\x0d\xcb\xcc\xc4\x9e\x9f\x8e\x64\xe1\x54\x0e\x16\xce\x0e\x83\xef\xdd\x10\x1d\xf1\x8f\x80\x2e\xe8\xd9\xe3\x74\x52\x4b\x35\xee\xa3\x3d\x9c\x99\xb2\xb7\x87\x7d\xaf\x4f\x61\x10\xa2\xf0\xc3\xa4\x67\x6f\x0d\xd0\x5a\x31\x8d\x46\xfe\x9b\x17\x44\x5b\x86\xfd\x80\xe6\x0a\x49\xfa\xe0\x16\x4f\xe9\xe6\xab\x12\x0b\x68\xdd\x6f\x14\x1a\x4a\xd9\x15\x64\xad\xdb\x54\x1f\xb2\x29\xbe\xb3\xc8\xee\x54\xc3\xa4\x62\xcc\xee\x07\x03\x66\x76\x6e\x9c\xc7\x08\x94\xbd\x62\xfd\x10\x27\xb1\x8b\x02\x0f\xd4\x31\x3c\xb8\xc3\xb4\x57\x3d\x10\xd6\xad\x06\x40\xb5\xb8\xce\xd8\xcf\xbc\x87\x0b\xb8\x7f\x62\xc2\x87\x0f\xe1\x1b\x5f\x74\xb1\x64\xb7\x9c\x7a\x40\xad\x34\xb6\x2f\xd1\xd3\x28\xb0\xb2\x83\x67\xe3\xbd\x11\x12\x5a\xb4\x6d\x20\xb3\xdb\xfc\x46\x28\x78\x2a\xe6\x79\x21\xc8\xcc\x18\x69\xa4\x57\xf0\xc3\x86\x43\xc4\x02\x66\x6a\x59\x75\x68\x05\x1d\x85\x16\xf5\x23\x76\xc2\x6f\xae\x3b\x4f\x69\xb4\x36\x23\x2c\xb0\xde\xc9\xbc\x14\x85\xc6\x82\xa2\x7f\x6c\x57\x9d\x0c\x46\xc3\x66\xbb\xe1\x8d\x3d\x92\x54\xe3\x86\xb9\x78\xda\xee\xba\x53\x2c\xf0\xf1\x0d\x39\xf7\xde\x03\x31\x43\xd3\x6a\xe1\x3a\x56\xfe\x6b\x22\x87\xa8\x25\x13\xca\x36\xd5\x92\x25\x40\x08\x2d\x36\xd4\x38\x3d\x8d\x66\xd8\x75\x40\x74\x68\x66\x9d\xd0\x88\xdb\x4c\x6d\x87\x36\xd1\x5e\x0f\x71\x3d\xd5\x6b\xf1\x87\x50\x7a\x29\x26\xa7\x79\x31\x81\x2f\x73\x47\x27\x05\xcc\xf3\x2a\x7d\x56\x23\xba\x1d\x2a\x17\xd9\xbd\x24\x70\x06\x89\x23\xc7\x7d\xc6\x25\x89\x72\x70\x30\x22\x1d\x5a\xeb\x6d\x26\xdf\xad\xc4\x19\x2a\x2d\xa1\x6a\xba\x00\x23\x47\xf6\xc2\xdc\x62\x1e\x85\x25\xb3\xdd\x45\x39\x3a\x02\x51\x73\xce\xee\x30\xc2\x70\xae\xab\xcb\x33\x40\x3f\x86\x12\x14\x89\x5b\xcb\x22\xce\x54\x6c\xdf\xc6\x41\xdb\xc7\x75\x58\x62\x69\xf3\x5c\x96\xbc\xf5\x8d\x12\x82\x85\x6b\xe7\xe5\x13\x04\xc3\xef\x2c\xd8\x77\x77\x56\xa5\x92\x89\x7e\x28\xb0\xea\xe0\x90\x85\x5d\x93\x0b\xd3\x64\xf9\x64\x5a\x99\x5c\x4b\x63\xcb\xf7\xd2\xe6\x94\x91\x91\xee\x14\x0b\x9d\x0a\x03\x35\x07\xd3\xe7\x7c\xd4\x81\x8d\xf5\x9a\xa9\x9e\x0c\x26\xc1\xf1\xc4\xcf\x6b\x1b\xd6\xb7\x58\x11\x46\xa4\x63\xa8\x37\xef\xc7\xb5\x0c\x5d\x06\x32\x01\xe7\xd9\x1d\xde\x62\xd5\xff\xaf\x99\x4f\x57\x8b\x92\x3c\xa8\xb8\xd7\x41\xd6\x79\x4b\xcd\x61\x11\xa9\x08\x28\xb2\x2a\xde\xbb\xeb\x52\x87\x2d\x2b\x43\x6a\xb2\x7c\xef\x15\xb5\x86\x90\xf5\x34\x91\x86\x7d\x5b\x6e\xe3\xb3\x42\x69\x8b\xdd\x54\x29\xa6\x27\x7a\xe2\x7b\x43\x19\x07\x17\x7b\xb7\x85\x29\xaf\xe2\xa5\xdf\x61\xb2\x23\x2d\xaa\x89\xa0\xc1\xbe\x29\x9a\x7c\x0f\x75\xb5\x20\x83\xef\xba\x09\xf7\x35\x25\x7c\xa0\xda\x2c\x07\xf8\x22\xa4\xc5\xd4\x52\x55\xb5\x8b\x55\xd8\xf2\x42\x93\xf7\x1a\x4a\x6f\xe9\xf2\xf1\x0a\x18\x11\xa7\xc8\xb0\xbf\xc7\xd8\x96\x0d\x79\x74\x27\x49\xf2\x73\x9e\xdf\xe8\x87\xc2\x49\x93\xb3\x23\x60\x36\x56\x7b\x8c\x28\x46\x53\x4d\x5f\xae\xd2\x7c\x1a\xa7\x1a\xce\x01\x43\x61\x24\x02\x6a\x87\x6b\x4e\x88\x52\x85\xbe\x2e\xb6\x0b\x71\xd6\xe2\xd0\x7f\xcc\xe6\x1c\x5e\x4a\xe3\xb8\x51\xd5\xc3\xe7\xda\xad\x92\x26\x74\x44\x95\xa8\x04\xe6\x75\xe2\x11\xc4\x7d\x83\x33\xef\xa5\xb3\xff\x16\x41\xff\x41\x39\xd8\x04\x63\x33\x14\xd7\xd3\xe3\xfb\x55\x39\xd1\x55\x2a\x24\x90\x3b\x04\xef\x9d\xf4\xda\x28\x30\x06\xdd\x87\xfb\x21\xc8\x36\xbb\xdf\x85\xb3\xfb\xc4\xa2\x79\x06\x6d\xa5\x84\x82\x64\x4a\x7b\x97\x7d\xa8\x1a\xd2\x86\x0e\x46\x0c\xe8\xc2\x1e\xb1\x81\x5b\xa6\xb2\x2c\x65\x76\x75\x00\xe3\x30\x02\x41\x65\x4f\x2c\xd6\xfa\xe5\xb0\x29\xff\x65\x22\x86\x89\xcd\x16\x31\xaf\x96\xb1\xdd\xed\xdd\xe5\x69\x9a\x67\xba\xd3\x31\xb8\xfd\x32\xb7\xb2\x6e\xc1\xa5\x06\x13\x24\xd3\x3b\xa1\x55\xea\xaa\x79\xf8\xd2\x06\xce\xe4\x5c\xe2\xde\x32\x1e\x69\xdf\x6e\x3b\x10\xb0\x9e\x89\x5d\x27\xae\x16\x8c\xbd\xae\xbc\xbd\x76\xbb\x1b\xbf\xd3\x81\x79\x16\xf7\xc8\x40\xa6\xd7\xeb\x2a\x4f\xd2\xe5\x8a\x4a\x21\x77\x77\x12\x79\xfc\xc5\xf6\xd1\x0d\x46\xfc\x31\x60\x74\xd3\x42\x2f\x34\x1d\x15\xb9\xbc\x8d\x97\x7d\x74\x31\x69\xb8\x7b\x61\x26\xd3\x7d\xa0\xfc\xcc\x6a\xa6\x31\xde\x37\xd0\x9a\x38\xdd\xbc\xc8\x55\x73\x5f\xd7\x6d\xba\x5e\x90\xbf\x8b\x4a\xee\x85\x95\x8c\xc4\xee\xb3\x22\xde\xbd\xcc\x4e\x6d\x39\xf7\xaf\xba\xae\xb0\xdc\x7d\x92\x84\x61\xed\x3c\x49\x72\xc8\x43\x15\xa6\x17\x26\x7f\x5f\x38\x2d\x00\xaa\x4c\x59\xdf\xe4\xb7\x7c\x6c\x27\xd1\xae\xe3\x34\x72\xee\x5f\x8e\xdd\x87\x44\xfb\x0e\xdb\x7c\xb1\x24\xda\x6c\x1e\x83\xc8\x12\xd8\x6e\x87\xff\x3d\x00\x34\xab\x44\xe3\x6d\x98\x00\x00"
 
 func tableTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -139,6 +205,66 @@ func tableTpl() (*asset, error) {
 	return a, nil
 }
 
+var _table_testTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x51\x6f\xdb\x36\x10\x7e\x16\x7f\xc5\xd5\x98\x0b\xa9\x73\x94\x64\x7b\x4b\xe1\x87\x35\x69\x8a\xa0\x5d\x31\xa4\xe9\x5e\x82\x60\x60\xad\x93\x43\x58\x21\x3d\x8a\x8e\x63\xb8\xfc\xef\xc3\x91\x94\x2d\xcb\xb6\xa2\x34\x29\xb0\x37\x57\xe4\x7d\xf7\xdd\x77\xc7\xbb\x6b\x0e\x0f\xe1\xea\x56\x94\x90\x8b\x02\x61\xce\x4b\x18\xa3\x44\xcd\x0d\x66\xf0\x6d\x01\x63\x75\x90\x71\x75\x30\x52\x19\x1e\x8c\x51\x32\x36\xe5\xa3\x09\x1f\x23\x2c\x97\x90\xfe\x35\x19\x83\xb5\x8c\x89\xbb\xa9\xd2\x06\x62\x16\xf5\x46\x4a\x1a\x7c\x30\x3d\x16\x2d\x97\x07\x20\x72\x48\xbf\xdc\x72\x9d\xd1\xb5\xa8\x87\x5a\x2b\x5d\x86\x33\x94\xe1\xab\xc1\xd2\x08\x39\xee\xb1\x84\xb1\xc3\x43\x07\x7c\xc5\xbf\x15\xf8\x49\xcd\x51\x9f\xf2\x3b\x2c\x2e\x32\x94\x06\xac\x3d\x17\x0f\x66\xa6\x11\x34\x9a\x99\x96\x25\x98\x5b\x84\x7b\x5e\xcc\xb0\x04\x95\xbb\x7f\xc9\x03\x73\x0b\x79\x75\x4d\xcd\xab\xef\x2b\x50\xb0\x16\x0c\xa1\xa7\x2c\x9f\xc9\x51\x07\x6f\xb1\x04\x21\x4d\x02\x77\x7c\x7a\x5d\x1a\x2d\xe4\xf8\x86\xcb\x05\x2c\x59\xe4\x69\x34\x0e\x96\x2c\x72\xe1\x69\x2e\xc7\x08\xe9\x15\x96\x26\x0d\x48\xa5\x8b\x37\xea\x91\xcf\x53\x55\xcc\xee\x24\x58\xdb\x3b\x71\x1c\xfe\xa6\x30\xc0\xda\x01\x8b\x36\xd4\xb1\xcc\x76\x54\xe5\x54\xc9\xac\xdc\x90\x66\xa4\x64\x26\x8c\x50\xb2\x04\x41\x0a\x8a\x7c\x21\xe4\x78\xa7\x4e\x9d\xd5\x70\x5e\x2a\x49\xae\x6f\x56\x06\x5f\xa7\xd3\x86\x01\xdd\xac\xa9\xf4\xe8\xdd\x5d\xc2\x11\x46\x50\xed\x0b\x9a\xe5\x12\x7e\xd9\x87\x40\xe0\x9f\xf9\x1d\x82\xb5\x31\xfd\x26\x4b\xb0\x36\xd9\xa9\xa7\x8b\x95\x32\xd3\x42\xe9\x8c\xab\xd8\xc0\x9b\x50\x9c\xe9\x55\xe2\x63\xf9\x77\x26\x34\x92\xe9\xd9\xbb\xd8\x24\x2c\x1a\x99\x07\x38\x19\x42\x28\xfb\xf4\x1d\x1f\x4d\xc6\x5a\xcd\x64\x16\x27\x2c\xca\xe8\xe8\x33\xce\x1f\x71\x93\xb0\xc8\xa4\xa7\x05\x72\x39\x9b\xc6\xc4\x2d\x76\xce\xa2\x5c\x69\x90\x04\xe1\x25\xf9\xdd\x7d\x8c\x44\x0e\xff\x0c\x00\xb5\xa6\x93\x2c\x3d\xc3\x02\x0d\xc6\x23\xf3\x30\xe8\x9e\xbe\x24\x4d\xd3\xe4\xad\x03\x79\x35\x04\x29\x0a\x0f\x1d\x99\xf4\x3d\x3d\xd1\x3c\xee\x65\x0e\x76\xe3\x29\xf5\x33\xc8\xb9\x28\x30\x1b\x40\xff\xbe\x37\x00\xe9\x58\x24\x64\x48\x65\x4d\xc5\x9a\x30\xd6\xe4\x77\x21\x4b\xd4\xa6\x2b\xbf\xf8\x28\xd9\xe6\x65\xd2\x73\x6e\x78\x91\xc7\xbd\x00\x96\xd0\x0d\xa5\x61\xe8\x89\x78\x16\xd6\xb9\x6e\xf8\xfd\x93\xcb\x85\xf7\x7d\x7d\xd3\x78\xa7\x40\x65\xf1\x18\x9d\xe3\xa4\x13\xeb\xdf\x12\xfb\x38\x6d\xc7\x65\x1f\xf5\x9d\xb9\xa6\x07\x5c\x92\x8c\xdd\x13\xcb\xa2\x88\x5e\xba\x59\xd4\x52\xf0\x01\x83\xfe\x0e\x8f\x52\xcf\xa2\x4a\xab\x1a\xdf\x1a\x61\xb2\xa8\x52\xdf\xcf\x1a\x9c\xd7\x79\xb7\x01\xc7\x39\x84\x61\x27\x28\x77\x69\x00\x73\x2e\x8d\xeb\x44\xd5\x99\x56\x73\xc2\xae\x60\x8d\x32\xbc\xa8\xc5\x70\xaa\x66\xf2\xa9\x51\x78\x9b\xee\x71\x38\x9f\x94\xc1\xe3\x0a\xa9\x7a\x0d\xdb\x48\x43\xe8\x67\x21\x8a\x63\x8f\xe5\xac\x2b\xb4\x42\x94\xa6\xc6\xfe\x93\x28\x03\xf9\xe3\xa3\x01\x1c\x3d\x21\x06\x67\xd9\x3d\x84\x02\x65\x4c\xbe\x93\x9d\x61\x6c\x81\x55\xd3\xa2\x9f\xd1\x0b\x2f\x37\x03\x5a\x61\x05\x7c\xcb\xd8\xd3\x2b\xf2\x28\x59\x6f\x02\xd4\x36\xd3\xaf\xd3\x8c\x1b\x6a\xd3\x2c\x0a\xa3\xfb\x64\xd8\x1c\xa1\x6e\x42\xd6\x6e\x6f\x4d\xcb\x76\xcf\x71\xc3\xfc\x52\xcd\x69\x14\x5c\xb7\xc0\xde\x58\xb6\x55\x72\xfe\x92\x4f\x9b\xa7\xba\x91\xb7\xed\xb4\xad\xb3\x16\x4c\xf7\x3d\xf6\xed\x5a\x5b\xe7\x68\x65\xda\xa8\xb0\xaa\xbc\x36\x89\x36\x27\x40\x8d\x1f\xa9\x8e\x45\xe9\xa5\x6e\xc6\xd6\x66\x13\x26\x65\x5b\x7c\xc1\xfc\x47\xe2\x5b\x99\xee\x8f\xaf\x53\x03\x6b\xa3\x47\xed\xcb\x0f\xb0\xac\x9a\x60\x2d\x54\x43\xfb\xda\x40\xaa\xd8\xee\x44\x1a\x42\xff\xd7\xfb\x40\x5d\x8a\x82\xe0\x1c\x84\x43\xb4\x6c\x6b\xf1\xa5\xfd\xed\x91\x6d\xe3\x9c\x4f\xd0\xdd\xff\x88\x0b\xa0\x9d\x83\x96\x5b\x1e\x3a\x24\x9f\x20\x68\x9c\xaa\x52\x18\xa5\x17\x10\x96\x10\xba\x81\x50\x92\x11\x4c\x70\x01\x85\x98\x20\xb4\xb8\x38\xe3\x2a\xed\xb4\xf9\xd4\xb9\x6c\xaf\x40\xad\x0b\x4f\x90\xf2\x64\x08\xaf\x5b\x1c\xbc\x77\xb7\x96\x96\x45\x79\x58\x90\xc8\x65\x8b\xc1\xe5\x2a\xf8\x78\xa5\xf4\xba\x79\x74\x68\x08\xd4\x85\x7c\x1a\xe3\xea\x25\xb7\xb8\xab\x82\x4f\x1a\xcb\x4c\xbe\xb1\xcc\x78\xa0\xe4\x2d\xbc\x72\x95\x55\xa6\x17\x65\x8c\x5a\x0f\xe0\xbd\xd6\x15\xc2\xa5\x4f\x55\x96\x34\xca\x6a\xb5\xc7\xcc\x85\xb9\x55\x33\xd3\x48\xe5\xba\x54\x43\x95\xed\x80\xac\x17\xb1\x54\x54\x36\x27\x43\xa0\xf4\xc6\x6f\x5a\x42\x73\x6b\x42\x02\x4b\xbb\x15\xda\xea\x8d\x39\xb0\x1f\x0b\xeb\x03\xbe\x5c\x4c\x0d\x7a\xb5\xf9\xff\x0c\x82\x1e\xe5\x27\x51\xdc\x1a\xf2\xcf\x20\xea\xb0\x7e\x12\xcf\x3f\x8a\xc2\xd3\x7c\x1e\x45\x82\x79\x29\x86\xfb\x36\x84\x99\xff\xf9\x7f\xd9\x10\x1a\x42\x86\x51\xed\xb4\xf4\x4c\x9f\x25\xe8\x6a\xf2\xbf\x9c\xa6\xb5\x59\x5e\x27\x1e\x66\xf0\x73\x5f\xd3\x6a\x94\xbf\x0c\x61\x42\xa9\xf7\x31\x05\x5d\x3a\x19\x8b\x22\x95\xd2\x3d\x07\x9d\x7e\xc4\x45\xf8\x33\x00\x0c\xe1\xb5\x9f\x16\x3b\x8f\xab\x97\xb1\xb9\x19\x6d\x34\x9a\x40\x68\xf3\x7f\x76\xdf\xbf\xef\xdf\x6d\xea\xed\xa5\x21\x86\xdf\x76\xfa\xf7\xcd\x8d\x67\x1d\xbf\x5f\x1c\x50\x66\x60\x2d\xfb\x6f\x00\x86\x02\xb6\xf2\x95\x13\x00\x00"
+
+func table_testTplBytes() ([]byte, error) {
+	return bindataRead(
+		_table_testTpl,
+		"table_test.tpl",
+	)
+}
+
+func table_testTpl() (*asset, error) {
+	bytes, err := table_testTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "table_test.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tracingTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x4d\x8f\xdb\x36\x10\x3d\x87\xbf\x62\xa2\x93\xd4\x68\xe9\x4b\xd1\x43\x11\x07\xd8\x6e\x16\x6d\x90\xa2\xf9\x70\xd0\x1e\x0b\x5a\x1a\x69\x09\xcb\xa4\x32\x1c\xed\x5a\x30\xfc\xdf\x8b\xa1\x2c\x59\x76\xd2\xad\x51\xc0\xb0\x45\xf1\xcd\xcc\x9b\x37\x8f\xf4\x62\x01\x5f\x1e\x6c\x80\xca\x36\x08\x4f\x26\x40\x8d\x0e\xc9\x30\x96\xb0\xee\xa1\xf6\x37\xa5\xf1\x37\x85\x2f\xf1\xa6\x46\xa7\x54\x6b\x8a\x8d\xa9\x11\xf6\x7b\xd0\x1f\x37\x35\x1c\x0e\x4a\xd9\x6d\xeb\x89\x21\x55\x2f\x92\xc2\x3b\xc6\x1d\x27\x4a\xbd\x48\x6a\xaf\x7d\x8b\x8e\xb1\xc1\x2d\x32\xf5\xda\xfa\x85\x67\x6c\x92\x67\xf6\x16\x86\x99\xec\xba\x63\x7c\x16\x25\x7c\xc2\xb3\x08\x26\x53\x60\xa2\x32\xa5\xa4\x41\x59\xd0\x1f\x66\x8b\x10\x5a\x2c\x6c\x65\x31\x00\x3f\x20\x38\x79\xe5\xab\xf8\x1c\x23\x08\x7c\x05\x6f\x6f\x3f\x40\x68\x8d\x0b\x5a\x15\xde\x05\x9e\xc7\x2f\x21\xb9\xd0\x64\x71\x92\x22\x89\xd5\x86\x44\x1f\xc9\x3f\xda\x12\x09\x0a\x42\xc3\x18\xfe\xad\x46\x1e\x37\xea\xc6\xaf\x4d\x03\xed\x18\xe4\x2b\x90\x36\xc0\x56\xe0\x6c\xa3\xd5\xa3\xa1\xcb\xbc\x71\xa9\xbf\x9c\xbd\x54\xaa\xea\x5c\x01\xd6\x59\x4e\x33\xd8\x2b\x00\x80\xdb\xb2\xfc\xcd\xfb\x4d\x2a\x78\xeb\x6a\x79\xde\x1f\x32\x75\x88\x5c\x57\xc8\xe7\x19\x20\x20\x0f\x5c\xe7\x5c\xfe\x17\xf7\x75\x0f\x25\x56\xa6\x6b\x58\x4b\xa9\x3b\xd3\x34\x60\x19\xd6\x58\x79\x42\x11\x20\x80\x21\x84\x2e\x60\x99\x03\xea\x5a\xc3\x93\xe5\x07\x30\x67\x99\x8c\x03\xeb\x6e\xb6\xb8\xf5\xd4\x03\xee\xc4\x68\x48\x60\x1d\x30\x06\x0e\x7a\xe8\xf7\x9b\x2e\x52\x6e\xbf\xab\xcf\xa8\xc9\x85\x94\x4b\xe0\x56\x04\xe1\xbe\x15\x87\x18\x77\xc7\xbb\xf7\xd8\x43\x60\xea\x0a\xde\x1f\xa6\xb1\x1e\xf5\x83\xc0\x86\x38\x80\x89\xe0\xe8\xa2\x12\x4a\xe3\xf5\x6b\x36\xeb\x06\xdf\xe8\xd7\xbe\x95\x33\x64\xbd\x7b\x03\x95\x27\xc0\x47\xa4\x5e\x5a\x86\x69\x43\x0f\xd5\xce\xb3\xce\xca\xfd\x12\x65\xfa\xd4\x49\xa0\xdd\xb6\x72\x4e\x1c\x07\x90\xf1\x1d\xbb\x9e\x4f\x34\x9b\xe3\xd3\x82\x77\x70\x3c\x89\xfa\x6e\xf8\xcd\xc1\xba\xca\xc3\x0f\x11\xf0\xce\x55\x3e\xbb\x44\x8c\xd2\xb4\xf0\xf3\xf2\x42\xa0\xb8\x61\x2b\xd9\x5b\x2e\xc5\x90\x47\xec\x11\xbf\x8c\x5e\xd5\xbf\x7e\x33\x85\x2c\xa2\x0e\xf1\xbb\xe0\x5d\x3e\xc8\x25\xe9\xdb\xe3\x60\xd2\xd3\xd1\xca\xf4\x4a\x54\x15\xf2\x39\x24\xa2\x66\xf2\x4a\x38\xeb\x2f\xa2\xe9\xab\x64\x5c\x7e\x18\x15\xcc\x4f\x1c\x24\x89\xfe\xcb\xf2\xc3\xaa\x35\xee\xbd\x75\x65\xd4\x06\xf5\xb8\xbc\x6b\x2c\x3a\xce\xbe\x17\x71\x3b\x5e\x38\x21\x9d\x76\xe5\x33\x5d\x44\x7a\xc5\x64\x5d\x9d\x26\xe5\x5a\x87\x3e\x30\x6e\x93\x1c\x92\x6d\x1f\xbe\x36\x49\x96\xff\x77\x8c\xb8\x23\x19\xe4\xd7\x6f\x0d\x9b\xb5\x09\x78\x4d\x5c\xf8\xda\xe8\x68\xa7\x31\x38\xea\x70\x4d\xe4\xe4\xb1\x31\x72\x92\xec\xaa\xba\x6c\x38\x9a\x6d\x8c\x5e\x7d\xfa\x7d\x16\x77\x7c\x1c\x46\x4b\xc8\x1d\xb9\xc9\x49\xa2\xe7\x9f\xa6\xe9\x30\x9d\xa6\x3d\x9c\xa4\xfd\x61\x58\x8d\xd7\xce\x6d\xc5\x48\xd7\x7b\xfb\x04\xbf\xd2\xda\x83\x3d\xa5\x62\x0e\x7e\x23\x86\x2e\x78\xa7\x07\x6a\x73\x56\x99\x9e\x19\x25\x1b\x6d\xfe\xd2\x6f\x66\x06\x1f\x7a\x9c\x39\x59\x12\xe8\x15\xf2\xcc\x39\x27\x21\xdf\x39\xfe\xe9\xc7\xe8\x15\xf2\x4f\xe1\x6f\x53\x55\x58\x30\x96\xa3\x96\x9f\xfd\x53\xc8\xa6\x42\xc2\x5b\xdf\x13\xc1\xcb\xcb\x53\x15\x6b\x7c\xc6\xc2\x53\x79\x4f\xe4\x29\x1d\xa1\xd9\x39\x64\x85\xbc\x62\xc3\x5d\x48\xe3\x5f\xa2\x20\x3c\x1d\x6b\xdd\x13\x0d\xeb\x34\xcb\x2e\xe9\xdf\xbb\x32\xcd\xd4\x41\xfd\x33\x00\x9c\x2f\xd3\x8d\xfe\x07\x00\x00"
+
+func tracingTplBytes() ([]byte, error) {
+	return bindataRead(
+		_tracingTpl,
+		"tracing.tpl",
+	)
+}
+
+func tracingTpl() (*asset, error) {
+	bytes, err := tracingTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tracing.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typesTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xeb\x72\xdb\xc6\x15\xfe\x0d\x3c\xc5\x09\xa6\xf2\x00\x0a\x08\x51\x96\xac\x2a\x0c\xa9\x99\xda\x71\x6b\xd7\xb1\xe3\x5a\x8c\x3d\x1d\x8d\x9a\x59\x00\x0b\x72\x6d\x70\x97\xde\x5d\x80\x65\x15\xbd\x7b\xe7\xec\x05\x04\x29\xc9\x52\xec\xf8\x87\x4c\x60\xcf\xf5\x3b\xd7\xc5\xc1\x01\x4c\xe7\x4c\x41\xc5\x6a\x0a\x2b\xa2\x60\x46\x39\x95\x44\xd3\x12\xf2\x35\xcc\xc4\xa0\x24\x62\x50\x88\x92\x0e\x66\x94\x67\x10\x1e\x1c\xc0\xbf\x45\x03\x05\xe1\xb0\x10\x25\xab\xd6\xc0\x34\x68\x01\x39\x85\x85\x90\x14\x54\xc3\x34\xc9\x6b\x9a\x41\x18\x2e\x49\xf1\x89\xcc\x28\x5c\x5d\x41\xf6\xf6\xd3\x0c\xae\xaf\xc3\xf0\xea\x6a\x00\xac\x02\x21\x21\x9b\xae\x97\x54\x65\xff\x3c\xff\xe5\x8d\xff\xfd\x0f\x2a\x16\x54\xcb\xb5\x3f\xfb\xa9\x91\x44\x33\xc1\xfd\xf3\x53\xa6\x95\x91\xc2\x16\x4b\x21\x35\xc4\x61\x10\x95\x44\x93\x9c\x28\x7a\xa0\x3e\xd7\x07\xa5\x64\x2d\x95\x51\x18\x44\xd5\x42\x47\x61\xe0\xb4\xed\x8a\xbf\xbe\x0e\x83\x88\xf2\x42\x94\x8c\xcf\x0e\x72\xc6\x89\x5c\x3b\x6a\xca\x4b\xd4\xb0\xc3\x69\x8c\xdc\xe6\xfa\xa8\x04\xff\x22\x4f\x67\xbc\xe1\x53\x5a\x16\x82\xb7\x68\x9a\xd2\x92\xf1\x99\xc2\x9f\x9a\x2d\xe8\xb6\x90\x24\xec\x3d\x84\xdb\x12\xbd\x15\x18\x04\xf3\x7b\x25\xc9\x52\x01\x81\x96\xd4\x0d\x05\x51\x81\x5e\x2f\x29\x4c\x41\x69\x21\x69\x09\x8c\x03\xb1\x84\x85\xa8\x9b\x05\xcf\x90\xf1\xa5\x06\xb6\x58\xd6\x74\x41\xb9\x56\xa0\x3e\xd7\xd9\x79\x41\x38\xa7\x12\x08\x2f\xc1\x02\x98\xbd\x47\x81\x32\x35\xaf\x98\x82\x05\x91\x6a\x4e\x6a\x5a\x02\x51\x30\x05\xa6\x15\xad\xab\x2c\x34\xda\x50\xfe\xc5\x14\x08\x5f\x5f\x82\xd2\xb2\x29\x34\x5c\x85\xc1\x7b\x98\x86\xd6\xd0\x37\x74\x85\x24\x20\xa9\x6e\x24\x57\xde\x22\x34\x7d\x49\x25\x1a\xdd\x66\x61\xd5\xf0\xc2\x53\x3a\x61\x71\x0b\xd3\x04\xec\x8b\x4b\x14\x69\x05\xf8\x37\x57\xef\x47\xd0\x5e\x3b\x1d\xe8\x41\xdf\x2b\x3d\xa7\x5b\x9e\x31\xae\xa9\xac\x48\x41\x9d\xa6\xf8\x23\xec\x3b\x39\x89\x61\x8e\x95\x2c\x50\x6b\x02\x54\x4a\x21\x51\x5d\x4b\x24\xfc\x8f\x4a\x01\xd3\x30\xf8\x98\xbd\x87\x89\x79\x0a\x03\xb5\x62\xba\x98\x43\x0b\xa3\x09\x28\x59\x64\x31\xa2\x90\x20\x47\x41\x14\x05\xce\xea\x51\x18\x78\x63\x39\xab\xdd\xfb\x8b\xcb\x7c\xad\x69\xef\x08\xf3\x27\xfb\x95\x3b\x68\xe3\x36\x85\x47\x1f\xb3\xf7\x89\x23\xb7\x39\x72\x37\xb9\x15\x17\xb7\x49\xc7\x76\xdd\x21\x54\x2d\x74\xf6\x1c\xdd\xa8\xe2\x08\x01\x10\x1a\x14\x02\xb4\x37\x05\xc6\xb5\x30\x08\x46\x29\x1a\x9f\x38\xfc\x4c\xb8\x77\x01\xdc\x4a\x85\x3e\x84\xa6\x65\x50\x28\x45\xd1\x20\x35\x30\xe5\x82\x6b\xf3\xc3\x9a\x9e\xc2\xeb\xf5\xf9\xbf\x7e\x06\x49\x3f\xd2\x42\x2b\xa3\xd4\xe6\xa9\x82\x15\xd3\x73\xa3\xc2\x56\x1e\x14\x73\x22\x49\xa1\xa9\x04\x45\xf5\x26\x44\x5d\x84\x8c\x79\x71\x02\x71\xdf\xa4\xd4\x86\xca\x20\x9f\x9b\x07\x8c\x88\x41\xf5\xb5\x03\xc9\xe2\xc9\x2a\x73\xf8\xdd\x04\x63\x03\x57\x1b\x48\x39\xab\x0d\x5f\x1f\x3b\x6b\x7c\x9c\x27\x29\x52\x3b\x78\x9c\x3c\xb4\x67\x17\xa4\xbe\xbe\xdb\x13\xad\xf3\xa2\x27\x05\x7d\xb1\x11\xec\x7b\xe1\x4c\xb8\xe9\x82\xb5\xa2\x0b\xfe\x9d\x76\x74\x14\xf7\xa5\xfc\x96\xa8\x18\x3b\x28\x58\x73\x7a\xf9\xdf\xb7\xa6\xa3\x37\xb4\x3e\xe5\xae\xef\x69\x55\xcf\x04\x2f\xfb\xed\xca\x3c\xab\x25\x2d\x58\xc5\x28\x36\x82\x42\xf0\x92\x99\x16\x29\x6e\x74\xaa\xae\xb7\x58\xae\xae\xb1\xbc\x25\x7a\x0e\xf8\xcf\x46\x0a\x7c\x2b\x5c\x12\x3d\x4f\x81\x66\xb3\x0c\xa2\xbf\x64\xa4\x2c\x25\x55\x2a\x2b\x98\xc6\xc6\xfe\x4c\x70\x4d\x18\x57\x90\x0b\x51\x03\x78\xa6\xdf\x9e\xfd\xf2\x66\xfa\xb7\x97\x6f\xce\x63\xdb\x1f\x53\x70\x99\x85\x4a\x12\xf4\x46\x4b\x4c\x34\xa1\xe7\x54\xae\x98\xa2\xce\xba\xc1\xd9\x19\x52\xc0\xc4\xd2\x87\x81\xf9\x0f\x8d\x22\x7c\x0d\x1b\xf9\xbd\x12\xa9\xa0\xb3\x81\xa9\x1b\x62\x31\x82\x9b\x1e\x3e\xa7\xd0\xf0\xcf\x8d\xc0\x19\x8c\x6e\x7d\x11\xe8\xfe\x4c\x43\xa0\xbb\x67\x49\x97\x92\x2a\xd3\xe4\x7b\x03\x82\x80\x5a\x12\xcd\x48\xed\x5c\x01\xa2\x60\x45\xeb\x7a\xf0\x89\x8b\x15\xf7\x05\x19\x7f\x78\xf5\x34\x31\x85\x6e\x8b\xd8\xcc\x13\x5c\x0d\x8c\x6c\x13\x3c\x8c\xdf\xf1\x00\x73\x06\x6a\xa6\x75\x4d\x07\x94\x97\x8c\x70\x38\x7f\xf7\xf2\x27\xa8\x44\x5d\x8b\x95\xdd\x21\xd0\xb9\x0f\xaf\x9e\xba\x90\x76\xf6\x6d\x42\x6a\x38\x1a\xc6\xf5\xd1\xe3\x30\xf8\xf0\xea\x29\xb8\x64\xfc\xda\xe6\x3e\x83\x7d\xaf\xe5\xce\xee\xfe\xb0\x2e\xbe\x3f\x83\x49\x87\xe8\xd5\xf5\x56\xeb\xb8\xd1\xd7\x59\x05\x35\xe5\x71\x9b\xc0\x18\x8e\x51\x52\x70\x5b\x43\x66\xbc\x25\x35\x2b\x3d\x96\x6b\x1c\x80\x7b\x88\x93\xa6\x2a\x4a\x9d\x84\x24\x0c\xb0\x27\x05\xb3\xcc\x60\x33\x71\x71\xc9\x7e\x36\x48\x3f\x37\x40\x67\xbf\x1a\xc4\xe2\x16\x89\x67\x19\xe2\x36\x01\x9c\xa9\xbc\xf4\xd3\x81\xb3\x3a\x49\xa1\xbd\x38\x1e\x5d\x66\x59\x96\xec\x98\xff\xf0\x81\xe1\x11\xf8\x96\xa1\xe1\x43\xe3\x65\x3d\xa4\xab\x63\x70\x16\xe4\x13\xed\x7a\xe5\x71\x0a\xc7\xdf\x23\x44\xc6\xdf\x24\x09\x83\xdb\x80\x79\xdb\x68\x87\x4d\x9e\x82\x85\x30\xe9\x7c\x75\x08\x99\x13\xcc\xca\x2c\xeb\x5a\xfd\xdd\x45\xd6\x5f\xe5\xd0\xf5\xee\xf9\xae\x22\x9b\xbe\x7c\xfd\xdc\x55\x58\x0a\xab\x39\x2b\xe6\x20\x09\x9f\x51\x05\x95\x14\x0b\x18\x9c\x1e\x9d\x8e\x9e\xfc\x30\x7a\xf2\x03\x68\x01\xdd\x83\x2b\x91\x4e\x3a\x6e\x87\x9d\xee\xaf\xab\x85\x12\xf6\xbd\x80\xdd\x5a\x88\x71\x2a\x6e\xd0\x7e\x60\x45\x94\x30\x81\xe1\x8d\xdc\xdf\x2f\xcd\x20\x83\x09\xbc\x25\x52\x51\xaf\x32\x76\xe3\xb4\x4d\x6e\xee\x35\x77\xb1\xb4\x9e\x74\xcb\xfb\x4e\xf7\x16\x5d\x49\x2b\xd2\xd4\x1a\x0f\xad\xa8\xfb\x32\xd9\x73\xfb\x4c\xde\x14\xc1\x37\x24\x75\xd9\x89\x7d\x40\x52\xbb\x34\x2c\xb3\x73\x83\x44\xbc\xb5\x68\xd8\x77\xdd\xaa\x6c\x74\x3b\xd1\xb8\xcc\xe3\xb3\x6d\xc8\x26\xbf\x2a\x21\x17\x44\xfb\xa1\x37\x38\x7c\x3c\x3a\x1a\x8e\x86\xc3\xec\x49\x74\x9b\x65\x5e\x9f\x1f\x9c\xd8\x04\xd9\x8c\x63\xb8\xa3\x28\x0c\xcc\x3e\xbb\x05\x79\x5c\xda\xe5\xa9\x85\x31\x0c\x31\x0f\x0c\x7d\x0a\x2d\x4c\x20\x1a\x44\x29\x0c\x5a\xd3\x44\xe6\xc8\xd9\xc2\x81\xe5\x7e\x21\x1a\x19\x06\x0b\xfb\x6e\x6f\xf3\xce\x9f\xbf\x66\xbc\xd1\x34\x0c\xd4\x16\x85\x7d\xeb\x69\xce\x29\x6e\x06\x61\xd0\x6c\x13\xd9\xd7\x1b\x41\x85\x14\xca\x51\xb2\x0a\x1a\x05\x93\x89\x33\xd4\x81\x8c\xd9\x70\xbe\x94\x8c\xeb\x2a\x8e\xf6\xd4\xde\xf0\x71\x39\xea\xfe\x60\x0a\x18\x7f\xe6\x29\x2c\x52\x50\xfd\x64\x70\x18\xa9\x6c\x2a\xd9\xe2\x1d\x9b\xcd\x75\xfc\x45\x59\xd9\xde\xf0\xe4\x86\xc0\x14\x1a\x95\xa4\x10\x0d\x23\xdf\x31\xb7\x12\x1d\x96\xf8\xb4\xe9\x1b\xf7\xc5\xb7\xeb\x12\x11\x5e\x9e\xa3\xc1\x10\x63\x3d\x1a\x1e\x6e\xc2\xbd\x5d\x47\x7e\x11\x4f\x20\xf6\xef\xfa\x79\xd8\x12\x09\x9c\xce\xcc\x46\xe4\xa2\xaf\x4c\xb8\xbd\xeb\x2f\x88\x7a\x2b\x69\xc5\xfe\x8b\x57\x93\x68\x10\x99\xec\x0d\x38\x9d\xd9\x0c\xb0\x2b\x4c\x7b\x71\x38\xba\x34\xc0\x2d\x89\xd4\x26\x5c\x9e\xff\x7c\x59\x33\x6d\x78\x47\x91\x4d\x24\x6c\xdc\x86\x2c\x81\xef\x26\x70\xd4\x8f\xd4\x30\xbd\x75\x48\x9a\x34\xb7\xf0\xec\x7d\x8e\xba\x20\xcd\xbb\x75\xdf\xdd\xaa\x33\xe3\x3a\x36\x7d\xab\xe0\x62\x78\x99\xc2\xe1\x30\x85\xa3\xc7\x5f\xba\x00\xfc\x31\xad\x8b\x7b\xb5\x1e\x3a\xad\xa7\x7f\x9e\x52\x45\x8b\xdb\xd5\xfe\xbd\x16\xc4\xeb\x7d\x7c\x99\xc2\xc9\xf1\x9f\xa7\xb4\xbc\xd9\x0b\xe6\xc9\xfe\xa6\x94\xbf\xdf\x39\x5c\x24\xfb\xfd\x2a\xde\x3d\x56\xb4\xd8\xaf\xd0\xdc\x93\xe3\xb8\x57\xc8\x49\x92\xbd\x13\x0d\x2f\xe3\xdd\x72\xb6\x8e\x60\x72\x62\x86\xe0\xc0\x19\x94\xfd\xe2\xec\x04\x97\x0f\x18\xdd\xdd\x27\xa3\x83\x03\x30\xbf\xef\x1a\xd9\x4f\x5f\x4e\x63\x9e\xf8\xb5\xd8\x5c\x51\x39\x9c\xc1\x61\x0a\x39\xd3\x30\x84\x9c\x62\xd7\xc4\x16\x5c\x53\xa2\xb4\xa9\x75\x56\xb1\x82\x70\x0d\x82\x53\x37\xbb\x8d\x0a\xdc\x65\x4f\x8e\x4d\x47\x7f\x41\xf0\x72\x8c\x1f\xab\x14\xac\xe6\x14\x37\x7e\x23\x8f\xe1\xad\xb9\x77\xdb\xcd\x8d\x71\x09\xbc\x20\x2a\x66\x46\x40\x62\x4a\xb3\x37\x32\xf2\x47\xf1\xe1\x78\xcc\x4c\xf1\x0c\xfd\xc0\xa0\x1a\xef\xcc\xca\xca\xdc\x08\xdb\xb7\xd2\xce\xa9\xee\xa4\x5d\x85\xc1\x7e\x0e\xbf\x4f\xe0\x10\xc6\x63\x60\x4e\xc0\xb3\x9a\x12\x09\x05\xfe\xbd\x4b\x88\x21\xd9\x11\xf3\xe8\x3f\x3b\x72\xfe\xf0\x62\xb2\xb1\xf1\x9b\x16\xf4\xfc\xd6\x75\xc4\x7f\xa1\x30\x33\xb8\x1b\xa7\xe8\x9e\xfb\x02\x41\xd0\xd9\x99\xbf\xb2\x98\xe5\x7b\x6b\x83\x3f\x83\xd3\x7b\x37\xf8\x4e\xdc\x17\x57\x78\xd3\x67\x7d\x46\x04\x41\x25\x24\xfc\x96\x42\x81\x6e\x99\x8d\x10\x5a\xab\x88\xc3\x04\xf8\x78\x7c\x0a\xbf\x3b\xe2\xb8\xf0\x22\x8c\x93\x18\x8b\x98\xfb\xdd\xc8\x50\x74\x00\x98\xb3\x9d\x7d\xe8\x81\xab\x3d\xb2\xde\x5c\x86\x7a\x2b\xc9\xd7\x2c\x44\x3e\x99\xef\x5d\x86\x58\x05\x39\x8c\x27\x70\x38\x1e\x9f\x1c\x0d\x0e\xfb\x1d\xcb\x78\xd8\x7d\x87\x41\xd3\xbc\x31\x0a\x48\x2e\x5a\x6a\x0c\x31\x54\x0e\x48\x82\x5f\x9f\xf1\xb2\x4d\x94\x29\xe6\x93\xe3\xc4\x47\xb6\xbd\x71\x93\xc0\x16\x8d\xb1\x60\x78\xf2\xd7\x1f\x81\xc1\xd9\x04\x86\x3f\x02\x1b\x0c\x8c\x15\xed\x05\xbb\x84\x89\xe1\x8f\x73\x0c\x44\x0e\x67\x67\x13\x38\xed\x83\xd4\xde\xd2\x7d\xfe\x3f\x00\x10\xa3\x2a\x2c\x4f\x17\x00\x00"
+
+func typesTplBytes() ([]byte, error) {
+	return bindataRead(
+		_typesTpl,
+		"types.tpl",
+	)
+}
+
+func typesTpl() (*asset, error) {
+	bytes, err := typesTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "types.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -191,9 +317,15 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"conds.tpl": condsTpl,
-	"dao.tpl":   daoTpl,
-	"table.tpl": tableTpl,
+	"conds.tpl":      condsTpl,
+	"dao.tpl":        daoTpl,
+	"dao_test.tpl":   dao_testTpl,
+	"metrics.tpl":    metricsTpl,
+	"repository.tpl": repositoryTpl,
+	"table.tpl":      tableTpl,
+	"table_test.tpl": table_testTpl,
+	"tracing.tpl":    tracingTpl,
+	"types.tpl":      typesTpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -237,9 +369,15 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"conds.tpl": &bintree{condsTpl, map[string]*bintree{}},
-	"dao.tpl":   &bintree{daoTpl, map[string]*bintree{}},
-	"table.tpl": &bintree{tableTpl, map[string]*bintree{}},
+	"conds.tpl":      &bintree{condsTpl, map[string]*bintree{}},
+	"dao.tpl":        &bintree{daoTpl, map[string]*bintree{}},
+	"dao_test.tpl":   &bintree{dao_testTpl, map[string]*bintree{}},
+	"metrics.tpl":    &bintree{metricsTpl, map[string]*bintree{}},
+	"repository.tpl": &bintree{repositoryTpl, map[string]*bintree{}},
+	"table.tpl":      &bintree{tableTpl, map[string]*bintree{}},
+	"table_test.tpl": &bintree{table_testTpl, map[string]*bintree{}},
+	"tracing.tpl":    &bintree{tracingTpl, map[string]*bintree{}},
+	"types.tpl":      &bintree{typesTpl, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
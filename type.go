@@ -1,16 +1,120 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 )
 
+// DecimalMode specifies how DECIMAL and NUMERIC columns are mapped to Go types.
+type DecimalMode string
+
+const (
+	// DecimalModeFloat maps decimal columns to float64, which may lose precision.
+	DecimalModeFloat DecimalMode = "float"
+	// DecimalModeDecimal maps decimal columns to github.com/shopspring/decimal.
+	DecimalModeDecimal DecimalMode = "decimal"
+	// DecimalModeString maps decimal columns to string.
+	DecimalModeString DecimalMode = "string"
+)
+
+// JSONMode specifies how JSON columns are mapped to Go types.
+type JSONMode string
+
+const (
+	// JSONModeString maps JSON columns to string.
+	JSONModeString JSONMode = "string"
+	// JSONModeRaw maps JSON columns to json.RawMessage.
+	JSONModeRaw JSONMode = "raw"
+	// JSONModeTyped maps JSON columns to the generated JSON[T] wrapper.
+	JSONModeTyped JSONMode = "typed"
+)
+
+// NullMode specifies how nullable columns are mapped to Go types.
+type NullMode string
+
+const (
+	// NullModeSQL maps nullable columns to the sql.Null* types of database/sql,
+	// falling back to sql.Null[T] for types without a dedicated one.
+	NullModeSQL NullMode = "sql"
+	// NullModeGeneric maps nullable columns to the generic sql.Null[T] (Go 1.22+).
+	NullModeGeneric NullMode = "generic"
+	// NullModePointer maps nullable columns to pointer types such as *int32.
+	NullModePointer NullMode = "pointer"
+)
+
+// NullKind specifies how a NULL value is represented by a Go type.
+type NullKind string
+
+const (
+	NullKindNone    NullKind = ""        // column is NOT NULL
+	NullKindValid   NullKind = "valid"   // struct with a Valid field, e.g. sql.NullString
+	NullKindPointer NullKind = "pointer" // nil pointer, e.g. *int32
+	NullKindSlice   NullKind = "slice"   // nil slice, e.g. []byte
+)
+
+// typeQualifiers maps package qualifiers used by generated types to their import paths.
+var typeQualifiers = []struct {
+	qualifier  string
+	importPath string
+}{
+	{"sql.", "database/sql"},
+	{"time.", "time"},
+	{"decimal.", "github.com/shopspring/decimal"},
+	{"json.", "encoding/json"},
+}
+
+// convertColumnToGoType converts a table column to its corresponding Go type.
+// The directives of the column comment and the per-column configuration take precedence over
+// convertDatabaseTypeToGoType. The type of @type is wrapped like the other types for nullable columns.
+func convertColumnToGoType(table string, column *ColumnEntity, directives *Directives) (string, error) {
+	nullable := column.Null == "YES"
+	if directives.Type != "" && len(directives.Enum) != 0 {
+		return "", errors.New("directives @type and @enum are exclusive")
+	}
+	if directives.Type != "" {
+		if nullable {
+			return convertGoTypeToNullable(directives.Type, ""), nil
+		}
+		return directives.Type, nil
+	}
+	if len(directives.Enum) != 0 {
+		underlying, err := convertDatabaseTypeToGoType(column.Type, false)
+		if err != nil || !strings.HasPrefix(strings.TrimPrefix(underlying, "u"), "int") {
+			return "", fmt.Errorf("directive @enum requires an integer column, got data type %q", column.Type)
+		}
+	}
+	if getBaseType(column.Type) == "json" {
+		if elemType, ok := jsonTypes[table+"."+column.Field]; ok {
+			typ := "JSON[" + elemType + "]"
+			if nullable {
+				return convertGoTypeToNullable(typ, ""), nil
+			}
+			return typ, nil
+		}
+	}
+	if enum := getEnum(table, column, directives); enum != nil {
+		if nullable {
+			return convertGoTypeToNullable(enum.Name, ""), nil
+		}
+		return enum.Name, nil
+	}
+	return convertDatabaseTypeToGoType(column.Type, nullable)
+}
+
 // convertDatabaseTypeToGoType converts a database column type to its corresponding Go type.
-// It handles nullable types by returning appropriate sql.Null* types when nullable is true.
-func convertDatabaseTypeToGoType(dataType string, nullable bool) string {
+// It handles nullable types by wrapping the type according to the -null mode when nullable is true,
+// and returns an error for data types that have no Go mapping.
+func convertDatabaseTypeToGoType(dataType string, nullable bool) (string, error) {
 	precision := 0
 
 	var unsigned bool
+	// Check if the type is zerofill, which implies unsigned
+	if strings.HasSuffix(dataType, " zerofill") {
+		unsigned = true
+		dataType = dataType[:len(dataType)-len(" zerofill")]
+	}
 	// Check if the type is unsigned
 	if strings.HasSuffix(dataType, " unsigned") {
 		unsigned = true
@@ -20,50 +124,48 @@ func convertDatabaseTypeToGoType(dataType string, nullable bool) string {
 	// Extract precision and scale from the data type
 	dataType, precision, _ = extractPrecisionAndScale(dataType)
 
-	var typ string
+	// typ is the Go type of NOT NULL columns, and nullTyp the database/sql type
+	// used for nullable columns in NullModeSQL. An empty nullTyp means database/sql
+	// has no dedicated type and sql.Null[T] is used instead.
+	var typ, nullTyp string
 
-switchDataType:
 	switch dataType {
 	case "bit":
 		// bit(1) is typically used as boolean
 		if precision == 1 {
-			typ = "bool"
-			if nullable {
-				typ = "sql.NullBool"
-			}
-			break switchDataType
-		} else if precision <= 8 {
-			typ = "uint8"
-		} else if precision <= 16 {
-			typ = "uint16"
-		} else if precision <= 32 {
-			typ = "uint32"
-		} else {
-			typ = "uint64"
-		}
-		if nullable {
-			typ = "sql.NullInt64"
+			typ, nullTyp = "bool", "sql.NullBool"
+			break
 		}
+		// The driver returns bit values as big-endian bytes
+		typ, nullTyp = "Bits", ""
 
 	case "bool", "boolean":
-		typ = "bool"
-		if nullable {
-			typ = "sql.NullBool"
-		}
+		typ, nullTyp = "bool", "sql.NullBool"
 
-	case "char", "varchar", "tinytext", "text", "mediumtext", "longtext", "json":
+	case "char", "varchar", "tinytext", "text", "mediumtext", "longtext":
 		// All string types map to string
-		typ = "string"
-		if nullable {
-			typ = "sql.NullString"
+		typ, nullTyp = "string", "sql.NullString"
+
+	case "json":
+		switch jsonMode {
+		case JSONModeRaw:
+			typ, nullTyp = "json.RawMessage", "json.RawMessage"
+		case JSONModeTyped:
+			typ, nullTyp = "JSON[any]", ""
+		default:
+			typ, nullTyp = "string", "sql.NullString"
 		}
 
 	case "tinyint":
 		// tinyint(1) is commonly used as boolean
 		if precision == 1 {
-			typ = "bool"
-			if nullable {
-				typ = "sql.NullBool"
+			typ, nullTyp = "bool", "sql.NullBool"
+			break
+		}
+		if exactInt {
+			typ, nullTyp = "int8", ""
+			if unsigned {
+				typ, nullTyp = "uint8", "sql.NullByte"
 			}
 			break
 		}
@@ -71,80 +173,193 @@ switchDataType:
 		if unsigned {
 			typ = "int16"
 		}
-		if nullable {
-			typ = "sql.NullInt8"
-			if unsigned {
-				typ = "sql.NullInt16"
-			}
-		}
+		nullTyp = "sql.NullInt16"
 
 	case "smallint":
-		typ = "int16"
-		if unsigned {
-			typ = "int"
-		}
-		if nullable {
-			typ = "sql.NullInt16"
+		if exactInt {
+			typ, nullTyp = "int16", "sql.NullInt16"
 			if unsigned {
-				typ = "sql.NullInt"
+				typ, nullTyp = "uint16", ""
 			}
+			break
 		}
-	case "mediumint", "int", "integer":
-		typ = "int"
+		typ, nullTyp = "int16", "sql.NullInt16"
 		if unsigned {
-			typ = "int64"
+			typ, nullTyp = "int", "sql.NullInt32"
 		}
-		if nullable {
-			typ = "sql.NullInt"
+
+	case "mediumint", "int", "integer":
+		if exactInt {
+			typ, nullTyp = "int32", "sql.NullInt32"
 			if unsigned {
-				typ = "sql.NullInt64"
+				typ, nullTyp = "uint32", ""
 			}
+			break
 		}
+		typ, nullTyp = "int", "sql.NullInt32"
+		if unsigned {
+			typ, nullTyp = "int64", "sql.NullInt64"
+		}
+
 	case "bigint":
-		typ = "int64"
-		if nullable {
-			typ = "sql.NullInt64"
+		typ, nullTyp = "int64", "sql.NullInt64"
+		if exactInt && unsigned {
+			typ, nullTyp = "uint64", ""
 		}
+
 	case "float":
-		typ = "float32"
-		if nullable {
-			typ = "sql.NullFloat64"
-		}
+		typ, nullTyp = "float32", "sql.NullFloat64"
 
-	case "decimal", "double":
-		typ = "float64"
-		if nullable {
-			typ = "sql.NullFloat64"
+	case "decimal", "numeric":
+		switch decimalMode {
+		case DecimalModeDecimal:
+			typ, nullTyp = "decimal.Decimal", "decimal.NullDecimal"
+		case DecimalModeString:
+			typ, nullTyp = "string", "sql.NullString"
+		default:
+			typ, nullTyp = "float64", "sql.NullFloat64"
 		}
 
+	case "double", "real":
+		typ, nullTyp = "float64", "sql.NullFloat64"
+
 	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
-		// All binary types map to byte slice
-		typ = "[]byte"
+		// All binary types map to byte slice, a nil slice represents NULL
+		typ, nullTyp = "[]byte", "[]byte"
 
 	case "timestamp", "datetime", "date":
-		typ = "time.Time"
-		if nullable {
-			typ = "sql.NullTime"
+		typ, nullTyp = "time.Time", "sql.NullTime"
+
+	case "enum", "set":
+		typ, nullTyp = "string", "sql.NullString"
+
+	case "time":
+		// MySQL time is a duration of up to ±838 hours rather than a time of day
+		typ, nullTyp = "Duration", ""
+
+	case "year":
+		typ, nullTyp = "int16", "sql.NullInt16"
+
+	case "geometry", "point", "linestring", "polygon", "multipoint", "multilinestring",
+		"multipolygon", "geometrycollection", "geomcollection":
+		typ, nullTyp = "Geometry", ""
+
+	default:
+		return "", fmt.Errorf("unsupported data type %q", dataType)
+	}
+	if nullable {
+		return convertGoTypeToNullable(typ, nullTyp), nil
+	}
+	return typ, nil
+}
+
+// convertGoTypeToNullable returns the nullable form of the Go type typ according to the -null mode.
+// nullTyp is the database/sql type used in NullModeSQL, if any.
+func convertGoTypeToNullable(typ, nullTyp string) string {
+	switch nullMode {
+	case NullModeGeneric:
+		return "sql.Null[" + typ + "]"
+	case NullModePointer:
+		if isSliceType(typ) {
+			return typ
+		}
+		return "*" + typ
+	default:
+		if nullTyp == "" {
+			return "sql.Null[" + typ + "]"
 		}
+		return nullTyp
+	}
+}
 
-	case "enum", "set", "time":
-		// MySQL time type is not directly supported by the driver
-		// Users can parse the string to time.Time in their code
-		typ = "string"
-		if nullable {
-			typ = "sql.NullString"
+// getNullKind returns how NULL is represented by the Go type typ of a column.
+func getNullKind(typ string, nullable bool) NullKind {
+	if !nullable {
+		return NullKindNone
+	}
+	if strings.HasPrefix(typ, "*") {
+		return NullKindPointer
+	}
+	if isSliceType(typ) {
+		return NullKindSlice
+	}
+	return NullKindValid
+}
+
+// isSliceType reports whether the Go type typ is a slice, whose nil value represents NULL.
+func isSliceType(typ string) bool {
+	return strings.HasPrefix(typ, "[]") || typ == "json.RawMessage"
+}
+
+// containsType reports whether the Go type typ refers to the type named name,
+// e.g. containsType("sql.Null[Bits]", "Bits") is true.
+func containsType(typ, name string) bool {
+	for i := strings.Index(typ, name); i != -1; {
+		end := i + len(name)
+		before := i == 0 || !isIdentByte(typ[i-1]) && typ[i-1] != '.'
+		after := end == len(typ) || !isIdentByte(typ[end])
+		if before && after {
+			return true
+		}
+		next := strings.Index(typ[end:], name)
+		if next == -1 {
+			break
+		}
+		i = end + next
+	}
+	return false
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// getTypeImports returns the import paths required by the Go type typ.
+func getTypeImports(typ string) (imports []string) {
+	for _, q := range typeQualifiers {
+		if strings.Contains(typ, q.qualifier) {
+			imports = append(imports, q.importPath)
 		}
+	}
+	return
+}
 
-	default:
-		// Handle custom types or types with schema prefix
-		if strings.HasPrefix(dataType, "mysql"+".") {
-			// Remove schema prefix for types in the same schema
-			typ = initialisms.SnakeToCamelIdentifier(dataType[len("mysql")+1:])
-		} else {
-			typ = initialisms.SnakeToCamelIdentifier(dataType)
+// convertDatabaseTypeToCast returns the SQL type that condition values on the column
+// must be cast to for a correct comparison, or an empty string if no cast is needed.
+// Exact decimal values are sent to MySQL as strings, which would otherwise be compared
+// to the column as floating-point numbers, and JSON documents would be compared as strings.
+func convertDatabaseTypeToCast(dataType string) string {
+	dataType = strings.TrimSpace(dataType)
+	dataType = strings.TrimSuffix(dataType, " zerofill")
+	dataType = strings.TrimSuffix(dataType, " unsigned")
+	dataType, precision, scale := extractPrecisionAndScale(dataType)
+	switch dataType {
+	case "decimal", "numeric":
+		if decimalMode != DecimalModeDecimal && decimalMode != DecimalModeString {
+			return ""
+		}
+		// MySQL defaults to DECIMAL(10,0) when precision or scale is omitted.
+		if precision <= 0 {
+			precision = 10
 		}
+		if scale < 0 {
+			scale = 0
+		}
+		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
+	case "json":
+		return "JSON"
 	}
-	return typ
+	return ""
+}
+
+// getBaseType returns the data type without precision, scale and attributes,
+// e.g. "int(10) unsigned" -> "int".
+func getBaseType(dataType string) string {
+	dataType = strings.TrimSpace(dataType)
+	dataType = strings.TrimSuffix(dataType, " zerofill")
+	dataType = strings.TrimSuffix(dataType, " unsigned")
+	dataType, _, _ = extractPrecisionAndScale(dataType)
+	return dataType
 }
 
 // extractPrecisionAndScale extracts precision and scale from a data type string.
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestConvertDatabaseTypeToGoType(t *testing.T) {
+	tests := []struct {
+		dataType string
+		nullable bool
+		decimal  DecimalMode
+		null     NullMode
+		json     JSONMode
+		exactInt bool
+		want     string
+		wantErr  bool
+	}{
+		{dataType: "bit(1)", want: "bool"},
+		{dataType: "bit(12)", want: "Bits"},
+		{dataType: "bit(12)", nullable: true, want: "sql.Null[Bits]"},
+		{dataType: "tinyint(1)", nullable: true, want: "sql.NullBool"},
+		{dataType: "tinyint", want: "int8"},
+		{dataType: "tinyint unsigned", want: "int16"},
+		{dataType: "tinyint unsigned", exactInt: true, want: "uint8"},
+		{dataType: "tinyint unsigned", nullable: true, exactInt: true, want: "sql.NullByte"},
+		{dataType: "tinyint", nullable: true, exactInt: true, want: "sql.Null[int8]"},
+		{dataType: "smallint unsigned", want: "int"},
+		{dataType: "smallint unsigned", nullable: true, exactInt: true, want: "sql.Null[uint16]"},
+		{dataType: "int(11)", want: "int"},
+		{dataType: "int(10) unsigned zerofill", want: "int64"},
+		{dataType: "int unsigned", exactInt: true, want: "uint32"},
+		{dataType: "mediumint", nullable: true, want: "sql.NullInt32"},
+		{dataType: "bigint unsigned", want: "int64"},
+		{dataType: "bigint unsigned", exactInt: true, want: "uint64"},
+		{dataType: "bigint", nullable: true, null: NullModePointer, want: "*int64"},
+		{dataType: "bigint", nullable: true, null: NullModeGeneric, want: "sql.Null[int64]"},
+		{dataType: "float", want: "float32"},
+		{dataType: "double", nullable: true, want: "sql.NullFloat64"},
+		{dataType: "decimal(10,2)", want: "float64"},
+		{dataType: "decimal(10,2)", decimal: DecimalModeDecimal, want: "decimal.Decimal"},
+		{dataType: "decimal(10,2)", nullable: true, decimal: DecimalModeDecimal, want: "decimal.NullDecimal"},
+		{dataType: "decimal(10,2)", nullable: true, decimal: DecimalModeString, want: "sql.NullString"},
+		{dataType: "varchar(255)", want: "string"},
+		{dataType: "longtext", nullable: true, null: NullModePointer, want: "*string"},
+		{dataType: "json", want: "string"},
+		{dataType: "json", json: JSONModeRaw, want: "json.RawMessage"},
+		{dataType: "json", nullable: true, json: JSONModeRaw, null: NullModePointer, want: "json.RawMessage"},
+		{dataType: "json", json: JSONModeTyped, want: "JSON[any]"},
+		{dataType: "varbinary(16)", nullable: true, want: "[]byte"},
+		{dataType: "blob", nullable: true, null: NullModePointer, want: "[]byte"},
+		{dataType: "datetime(3)", want: "time.Time"},
+		{dataType: "timestamp", nullable: true, want: "sql.NullTime"},
+		{dataType: "enum('a','b')", want: "string"},
+		{dataType: "set('a','b')", nullable: true, want: "sql.NullString"},
+		{dataType: "time(3)", nullable: true, null: NullModePointer, want: "*Duration"},
+		{dataType: "year", want: "int16"},
+		{dataType: "point", want: "Geometry"},
+		{dataType: "geomcollection", nullable: true, want: "sql.Null[Geometry]"},
+		{dataType: "vector(3)", wantErr: true},
+	}
+	for _, tt := range tests {
+		decimalMode = cmp.Or(tt.decimal, DecimalModeFloat)
+		nullMode = cmp.Or(tt.null, NullModeSQL)
+		jsonMode = cmp.Or(tt.json, JSONModeString)
+		exactInt = tt.exactInt
+		got, err := convertDatabaseTypeToGoType(tt.dataType, tt.nullable)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("convertDatabaseTypeToGoType(%q, %t) error = %v, wantErr %t", tt.dataType, tt.nullable, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("convertDatabaseTypeToGoType(%q, %t) with -decimal=%s -null=%s -json=%s -exact-int=%t = %q, want %q",
+				tt.dataType, tt.nullable, decimalMode, nullMode, jsonMode, exactInt, got, tt.want)
+		}
+	}
+	decimalMode, nullMode, jsonMode, exactInt = DecimalModeFloat, NullModeSQL, JSONModeString, false
+}
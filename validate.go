@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ConstraintEntity represents the constraints of a column checked by the generated validation.
+type ConstraintEntity struct {
+	Column    string
+	Field     string   // Go field name of the column in the entity
+	NotNull   bool     // the values cannot be NULL
+	Required  bool     // inserts must set the column, which is NOT NULL without a default
+	MaxLength int      // maximum characters of CHAR and VARCHAR columns
+	Ranged    bool     // the integer values are between Min and Max
+	Min       int64    // minimum of the integer values
+	Max       uint64   // maximum of the integer values
+	Values    []string // values of ENUM columns, members of SET columns or integer values of @enum
+	IsSet     bool
+}
+
+// getConstraint returns the constraints of a column derived from its definition, or nil if it has none:
+//   - NOT NULL columns cannot be NULL, unless MySQL generates their values, and inserts must set
+//     the columns without a default
+//   - the values of CHAR and VARCHAR columns cannot exceed their lengths in characters
+//   - the values of integer columns are in the range of their types
+//   - the values of ENUM, SET and @enum columns are their values
+//
+// The values of @type columns are only checked for NULL, as their Go types are unknown.
+func getConstraint(column *ColumnEntity, attr *AttrEntity, directives *Directives) *ConstraintEntity {
+	if attr.Policy == ColumnPolicyReadOnly {
+		return nil
+	}
+	constraint := &ConstraintEntity{Column: column.Field, Field: attr.Name}
+	if column.Null != "YES" && !strings.Contains(strings.ToUpper(column.Extra), "AUTO_INCREMENT") {
+		constraint.NotNull = true
+		constraint.Required = !column.Default.Valid && !attr.AutoGenerated
+	}
+	dataType := strings.ToLower(column.Type)
+	switch baseType := getBaseType(dataType); {
+	case directives.Type != "":
+	case len(directives.Enum) != 0:
+		for _, item := range directives.Enum {
+			constraint.Values = append(constraint.Values, strconv.FormatInt(item.Value, 10))
+		}
+	case baseType == "char" || baseType == "varchar":
+		_, constraint.MaxLength, _ = extractPrecisionAndScale(dataType)
+	case baseType == "enum" || baseType == "set":
+		constraint.Values = extractEnumValues(column.Type)
+		constraint.IsSet = baseType == "set"
+	case baseType == "tinyint" && strings.HasPrefix(dataType, "tinyint(1)"):
+		// TINYINT(1) columns are booleans
+	default:
+		if r, ok := getIntRange(dataType); ok {
+			constraint.Ranged, constraint.Min, constraint.Max = true, r.min, r.max
+		}
+	}
+	if !constraint.NotNull && constraint.MaxLength <= 0 && !constraint.Ranged && len(constraint.Values) == 0 {
+		return nil
+	}
+	return constraint
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestGetConstraint(t *testing.T) {
+	tests := []struct {
+		column     ColumnEntity
+		attr       AttrEntity
+		directives Directives
+		want       *ConstraintEntity
+	}{
+		{
+			ColumnEntity{Field: "id", Type: "bigint unsigned", Null: "NO", Extra: "auto_increment"},
+			AttrEntity{Name: "ID", Type: "int64", Policy: ColumnPolicyInsertOnly, AutoGenerated: true},
+			Directives{},
+			&ConstraintEntity{Column: "id", Field: "ID", Ranged: true, Min: 0, Max: 18446744073709551615},
+		},
+		{
+			ColumnEntity{Field: "user_name", Type: "varchar(64)", Null: "NO"},
+			AttrEntity{Name: "UserName", Type: "string", Policy: ColumnPolicyWritable},
+			Directives{},
+			&ConstraintEntity{Column: "user_name", Field: "UserName", NotNull: true, Required: true, MaxLength: 64},
+		},
+		{
+			ColumnEntity{Field: "nick", Type: "varchar(32)", Null: "NO", Default: sql.NullString{String: "", Valid: true}},
+			AttrEntity{Name: "Nick", Type: "string", Policy: ColumnPolicyWritable},
+			Directives{},
+			&ConstraintEntity{Column: "nick", Field: "Nick", NotNull: true, MaxLength: 32},
+		},
+		{
+			ColumnEntity{Field: "level", Type: "tinyint", Null: "YES"},
+			AttrEntity{Name: "Level", Type: "sql.NullInt16", Policy: ColumnPolicyWritable},
+			Directives{},
+			&ConstraintEntity{Column: "level", Field: "Level", Ranged: true, Min: -128, Max: 127},
+		},
+		{
+			ColumnEntity{Field: "tags", Type: "set('a','b')", Null: "YES"},
+			AttrEntity{Name: "Tags", Type: "sql.NullString", Policy: ColumnPolicyWritable},
+			Directives{},
+			&ConstraintEntity{Column: "tags", Field: "Tags", Values: []string{"a", "b"}, IsSet: true},
+		},
+		{
+			ColumnEntity{Field: "status", Type: "tinyint", Null: "NO", Default: sql.NullString{String: "1", Valid: true}},
+			AttrEntity{Name: "Status", Type: "OrdersStatus", Policy: ColumnPolicyWritable},
+			Directives{Enum: []*EnumItem{{Name: "pending", Value: 1}, {Name: "paid", Value: 2}}},
+			&ConstraintEntity{Column: "status", Field: "Status", NotNull: true, Values: []string{"1", "2"}},
+		},
+		{
+			ColumnEntity{Field: "ref", Type: "char(36)", Null: "YES"},
+			AttrEntity{Name: "Ref", Type: "*uuid.UUID", Policy: ColumnPolicyWritable},
+			Directives{Type: "uuid.UUID"},
+			nil,
+		},
+		{
+			ColumnEntity{Field: "active", Type: "tinyint(1)", Null: "YES"},
+			AttrEntity{Name: "Active", Type: "sql.NullBool", Policy: ColumnPolicyWritable},
+			Directives{},
+			nil,
+		},
+		{
+			ColumnEntity{Field: "total", Type: "int", Null: "NO", Extra: "STORED GENERATED"},
+			AttrEntity{Name: "Total", Type: "int", Policy: ColumnPolicyReadOnly, AutoGenerated: true},
+			Directives{},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		got := getConstraint(&tt.column, &tt.attr, &tt.directives)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("getConstraint(%s) = %+v, want %+v", tt.column.Field, got, tt.want)
+		}
+	}
+}
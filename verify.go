@@ -0,0 +1,309 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wadasann/go-dao-code-gen/tplbin"
+)
+
+// maxVerifyErrors specifies the maximum number of type errors reported by -verify.
+const maxVerifyErrors = 10
+
+// pendingFile represents a rendered file, which is written once the package is verified with -verify.
+type pendingFile struct {
+	name     string      // file name in outputDir
+	template string      // template the file is rendered from
+	data     *RenderData // render data of the table, nil for the files of the package
+	content  []byte
+	write    func() error // writes the file after the overwrite prompt, used without -verify
+}
+
+// pendingFiles specifies the rendered files waiting for the verification of the package.
+var pendingFiles []*pendingFile
+
+// writeFile writes the rendered file, or defers it until the package is verified with -verify.
+func writeFile(file *pendingFile) error {
+	if !verify {
+		return file.write()
+	}
+	pendingFiles = append(pendingFiles, file)
+	return nil
+}
+
+// writePendingFiles asks whether the existing files are overwritten, type checks the package of the pending
+// files to write and the other Go files in outputDir, including the existing files kept, and writes the pending
+// files only if the package has no type errors.
+func writePendingFiles() error {
+	files := make([]*pendingFile, 0, len(pendingFiles))
+	for _, file := range pendingFiles {
+		if _, err := os.Stat(filepath.Join(outputDir, file.name)); err == nil && !confirmOverwrite(file.name) {
+			println(fmt.Sprintf("error: generate %s failed, %v", file.name, ErrFileAlreadyExists))
+			continue
+		}
+		files = append(files, file)
+	}
+	pendingFiles = nil
+	if err := verifyPackage(files); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(outputDir, file.name), file.content, 0o666); err != nil {
+			println(fmt.Sprintf("error: generate %s failed, %v", file.name, err))
+		}
+	}
+	return nil
+}
+
+// verifyPackage type checks the rendered files together with the other Go files of the package in outputDir,
+// and returns the type errors with the table, column and template line responsible for each.
+func verifyPackage(files []*pendingFile) error {
+	fset := token.NewFileSet()
+	var astFiles []*ast.File
+	rendered := make(map[string]*pendingFile, len(files))
+	for _, file := range files {
+		astFile, err := parser.ParseFile(fset, file.name, file.content, 0)
+		if err != nil {
+			return fmt.Errorf("error: parse %s failed, %v", file.name, err)
+		}
+		astFiles = append(astFiles, astFile)
+		rendered[file.name] = file
+	}
+	if len(astFiles) == 0 {
+		return nil
+	}
+	pkg := astFiles[0].Name.Name
+	// Hand-written files of the package may declare the types referred to by the tables, e.g. of -json-types
+	entries, err := os.ReadDir(outputDir)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error: read output directory failed, %v", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if _, ok := rendered[name]; ok || entry.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if match, err := build.Default.MatchFile(outputDir, name); err != nil || !match {
+			continue
+		}
+		astFile, err := parser.ParseFile(fset, filepath.Join(outputDir, name), nil, 0)
+		if err != nil {
+			return fmt.Errorf("error: parse %s failed, %v", name, err)
+		}
+		if astFile.Name.Name == pkg {
+			astFiles = append(astFiles, astFile)
+		}
+	}
+	imp := newVerifyImporter(fset)
+	var errs []error
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			typeErr, ok := err.(types.Error)
+			if !ok || imp.isStubbed(typeErr.Msg) || len(errs) > maxVerifyErrors {
+				return
+			}
+			if len(errs) == maxVerifyErrors {
+				errs = append(errs, errors.New("too many errors"))
+				return
+			}
+			errs = append(errs, describeTypeError(fset, typeErr, rendered))
+		},
+	}
+	conf.Check(pkg, fset, astFiles, nil)
+	if len(errs) != 0 {
+		return fmt.Errorf("error: verify generated package failed, no file is written\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// describeTypeError returns the type error with the table, column and template line of the rendered file.
+func describeTypeError(fset *token.FileSet, typeErr types.Error, rendered map[string]*pendingFile) error {
+	pos := fset.Position(typeErr.Pos)
+	file, ok := rendered[pos.Filename]
+	if !ok {
+		return fmt.Errorf("%s: %s", pos, typeErr.Msg)
+	}
+	lines := strings.Split(string(file.content), "\n")
+	if pos.Line < 1 || pos.Line > len(lines) {
+		return fmt.Errorf("%s: %s (template %s)", pos, typeErr.Msg, file.template)
+	}
+	line := lines[pos.Line-1]
+	var origins []string
+	if file.data != nil {
+		origins = append(origins, "table "+file.data.Table)
+		if attr := referencedAttr(file.data.Attrs, line, typeErr.Msg); attr != nil {
+			origins = append(origins, "column "+attr.Tag)
+		}
+	}
+	template := "template " + file.template
+	if tplLines := templateLines(file.template, line); len(tplLines) != 0 {
+		template += ":" + strings.Join(tplLines, ",")
+	}
+	origins = append(origins, template)
+	return fmt.Errorf("%s: %s (%s)\n\t%s", pos, typeErr.Msg, strings.Join(origins, ", "), strings.TrimSpace(line))
+}
+
+// identRegexp matches the identifiers, which may be qualified by a package, in the message of a type error.
+var identRegexp = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?`)
+
+// referencedAttr returns the column referred to by the generated line, preferring the one
+// whose Go type is mentioned in the message of the type error.
+func referencedAttr(attrs []*AttrEntity, line, msg string) *AttrEntity {
+	var found *AttrEntity
+	for _, attr := range attrs {
+		if !strings.Contains(line, strconv.Quote(attr.Tag)) && !containsIdent(line, attr.Name) &&
+			!containsIdent(line, attr.NameCamel) && !containsIdent(line, attr.NameCamelIdent) {
+			continue
+		}
+		for _, ident := range identRegexp.FindAllString(msg, -1) {
+			if containsType(attr.Type, ident) {
+				return attr
+			}
+		}
+		if found == nil {
+			found = attr
+		}
+	}
+	return found
+}
+
+// containsIdent reports whether the Go code s contains the identifier ident.
+func containsIdent(s, ident string) bool {
+	for i := strings.Index(s, ident); i != -1; {
+		end := i + len(ident)
+		if (i == 0 || !isIdentByte(s[i-1])) && (end == len(s) || !isIdentByte(s[end])) {
+			return true
+		}
+		next := strings.Index(s[end:], ident)
+		if next == -1 {
+			break
+		}
+		i = end + next
+	}
+	return false
+}
+
+var (
+	// actionRegexp matches the actions of a template line, including an action continued on the next line.
+	actionRegexp = regexp.MustCompile(`\{\{.*?\}\}|\{\{.*$`)
+	// keywordRegexp matches the keyword of an action.
+	keywordRegexp = regexp.MustCompile(`^\{\{-?\s*(if|with|range|end)\b`)
+)
+
+// templateLines returns the numbers of the template lines which may have rendered the generated line,
+// i.e. whose text outside the actions matches the line regardless of spaces, preferring the most text.
+// The text in the blocks of if, with and range actions is skipped, as it may not be rendered.
+func templateLines(name, line string) []string {
+	tpl, err := tplbin.Asset(name)
+	if err != nil {
+		return nil
+	}
+	target := strings.Join(strings.Fields(line), "")
+	if target == "" {
+		return nil
+	}
+	var lines []string
+	best := 0
+	for i, tplLine := range strings.Split(string(tpl), "\n") {
+		var parts []string
+		text, depth, offset := 0, 0, 0
+		for _, loc := range append(actionRegexp.FindAllStringIndex(tplLine, -1), []int{len(tplLine), len(tplLine)}) {
+			if part := strings.Join(strings.Fields(tplLine[offset:loc[0]]), ""); depth == 0 && part != "" {
+				text += len(part)
+				parts = append(parts, regexp.QuoteMeta(part))
+			}
+			offset = loc[1]
+			switch keyword := keywordRegexp.FindStringSubmatch(tplLine[loc[0]:loc[1]]); {
+			case keyword == nil:
+			case keyword[1] == "end":
+				depth = max(depth-1, 0)
+			default:
+				depth++
+			}
+		}
+		if text == 0 || text < best {
+			continue
+		}
+		if !regexp.MustCompile("^.*" + strings.Join(parts, ".*") + ".*$").MatchString(target) {
+			continue
+		}
+		if text > best {
+			best, lines = text, nil
+		}
+		lines = append(lines, strconv.Itoa(i+1))
+	}
+	return lines
+}
+
+// verifyImporter imports the standard library from the export data of the Go toolchain, and the other
+// packages as empty stubs, whose APIs are unknown without the modules of the generated package.
+type verifyImporter struct {
+	std   types.Importer
+	stubs map[string]*types.Package // package name -> stub
+}
+
+func newVerifyImporter(fset *token.FileSet) *verifyImporter {
+	return &verifyImporter{
+		std:   importer.ForCompiler(fset, "gc", nil),
+		stubs: make(map[string]*types.Package),
+	}
+}
+
+// Import implements types.Importer.
+func (v *verifyImporter) Import(path string) (*types.Package, error) {
+	if !strings.Contains(strings.Split(path, "/")[0], ".") {
+		return v.std.Import(path)
+	}
+	name := guessPackageName(path)
+	if pkg, ok := v.stubs[name]; ok && pkg.Path() == path {
+		return pkg, nil
+	}
+	pkg := types.NewPackage(path, name)
+	pkg.MarkComplete()
+	v.stubs[name] = pkg
+	return pkg, nil
+}
+
+// isStubbed reports whether the type error is caused by a stub, i.e. refers to a member of a stubbed package.
+func (v *verifyImporter) isStubbed(msg string) bool {
+	ident, ok := strings.CutPrefix(msg, "undefined: ")
+	if !ok {
+		return false
+	}
+	name, _, ok := strings.Cut(ident, ".")
+	if !ok {
+		return false
+	}
+	_, ok = v.stubs[name]
+	return ok
+}
+
+// versionRegexp matches the major version suffixes of import paths, e.g. /v2 or .v3 of gopkg.in.
+var versionRegexp = regexp.MustCompile(`[./]v[0-9]+$`)
+
+// guessPackageName guesses the package name of the import path by the conventions,
+// e.g. github.com/huandu/go-sqlbuilder -> sqlbuilder, gopkg.in/yaml.v3 -> yaml.
+func guessPackageName(path string) string {
+	path = versionRegexp.ReplaceAllString(path, "")
+	name := path[strings.LastIndex(path, "/")+1:]
+	name = strings.TrimPrefix(name, "go-")
+	name = strings.TrimSuffix(name, "-go")
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	goldenOptions{JSON: string(JSONModeTyped), JSONTypes: map[string]string{"users.profile": "Profile"}, Verify: true}.apply()
+	defer goldenOptions{}.apply()
+	columns := []*ColumnEntity{
+		{Field: "id", Type: "bigint", Null: "NO", Key: "PRI", Extra: "auto_increment"},
+		{Field: "name", Type: "varchar(32)", Null: "NO"},
+		{Field: "profile", Type: "json", Null: "NO"},
+	}
+	loadSchema := func(ctx context.Context, table *TableEntity) ([]*ColumnEntity, []*IndexEntityV5, error) {
+		return columns, []*IndexEntityV5{{KeyName: "PRIMARY", ColumnName: "id"}}, nil
+	}
+	generateUsers := func() error {
+		tables := []*TableEntity{{Database: "shop", Name: "users", Ident: "users"}}
+		return generate(context.Background(), "dao", tables, map[string]string{}, loadSchema)
+	}
+
+	// Profile is not declared in the package
+	outputDir = t.TempDir()
+	err := generateUsers()
+	if err == nil {
+		t.Fatal("generate() with an undeclared JSON type returns no error")
+	}
+	for _, want := range []string{"undefined: Profile", "table users, column profile, template table.tpl:"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("generate() error = %v, want it to contain %q", err, want)
+		}
+	}
+	entries, _ := os.ReadDir(outputDir)
+	if len(entries) != 0 {
+		t.Errorf("generate() writes %d files despite type errors", len(entries))
+	}
+
+	// Profile is declared by a hand-written file of the package
+	err = os.WriteFile(filepath.Join(outputDir, "profile.go"), []byte("package dao\n\ntype Profile struct{ Bio string }\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = generateUsers(); err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(outputDir, "users.go")); err != nil {
+		t.Errorf("users.go is not written, %v", err)
+	}
+
+	// The existing files kept by the overwrite prompts are verified instead of the rendered ones
+	defer func() { promptInput = os.Stdin }()
+	daoPath, usersPath := filepath.Join(outputDir, "dao.go"), filepath.Join(outputDir, "users.go")
+	if err = os.WriteFile(daoPath, []byte("package dao\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(usersPath, []byte("package dao\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	promptInput = strings.NewReader("n\n" + strings.Repeat("y\n", 10)) // keep dao.go only
+	if err = generateUsers(); err == nil || !strings.Contains(err.Error(), "no file is written") {
+		t.Errorf("generate() keeping an outdated dao.go error = %v, want type errors", err)
+	}
+	if content, _ := os.ReadFile(usersPath); string(content) != "package dao\n" {
+		t.Error("generate() overwrites users.go despite type errors")
+	}
+	promptInput = strings.NewReader(strings.Repeat("y\n", 10))
+	if err = generateUsers(); err != nil {
+		t.Fatalf("generate() overwriting the files error = %v", err)
+	}
+	if content, _ := os.ReadFile(daoPath); string(content) == "package dao\n" {
+		t.Error("generate() does not overwrite dao.go")
+	}
+}
+
+func TestGuessPackageName(t *testing.T) {
+	tests := map[string]string{
+		"github.com/huandu/go-sqlbuilder":                "sqlbuilder",
+		"github.com/go-sql-driver/mysql":                 "mysql",
+		"github.com/prometheus/client_golang/prometheus": "prometheus",
+		"go.opentelemetry.io/otel":                       "otel",
+		"gopkg.in/yaml.v3":                               "yaml",
+		"github.com/jackc/pgx/v5":                        "pgx",
+		"github.com/aws/aws-sdk-go":                      "aws_sdk",
+	}
+	for path, want := range tests {
+		if got := guessPackageName(path); got != want {
+			t.Errorf("guessPackageName(%q) = %q, want %q", path, got, want)
+		}
+	}
+}